@@ -84,17 +84,88 @@ type ChartSpec struct {
 	// +kubebuilder:default:="dapr"
 	Name string `json:"name,omitempty"`
 
+	// Version is either an exact chart version or a semver constraint such as ~1.16.0
+	// or ">=1.15 <1.17". Constraints are periodically resolved against the repository
+	// so new matching versions get applied automatically.
 	// +kubebuilder:validation:Optional
 	Version string `json:"version,omitempty"`
 
 	// +kubebuilder:validation:Optional
 	Secret string `json:"secret,omitempty"`
+
+	// ResolveInterval is how often the repository is queried to resolve a version
+	// constraint and to look for available updates, defaults to 1h.
+	// +kubebuilder:validation:Optional
+	ResolveInterval *metav1.Duration `json:"resolveInterval,omitempty"`
 }
 
 type ChartMeta struct {
 	Repo    string `json:"repo,omitempty"`
 	Name    string `json:"name,omitempty"`
 	Version string `json:"version,omitempty"`
+
+	// Constraint is the version constraint the version has been resolved from.
+	Constraint string `json:"constraint,omitempty"`
+
+	// ResolvedAt is the last time the version constraint has been resolved.
+	ResolvedAt *metav1.Time `json:"resolvedAt,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window in which changes to the control plane can be applied.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression defining when the window opens, i.e. "0 2 * * SAT".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open, at least one minute as the schedule has a
+	// granularity of one minute.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="duration must be at least 1m"
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the name of the IANA time zone the schedule is evaluated in, defaults to UTC.
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ApprovalPolicy defines how changes to the control plane are approved.
+type ApprovalPolicy string
+
+const (
+	// ApprovalPolicyAutomatic applies changes as soon as they are requested.
+	ApprovalPolicyAutomatic ApprovalPolicy = "Automatic"
+	// ApprovalPolicyManual applies changes only once the related plan has been approved.
+	ApprovalPolicyManual ApprovalPolicy = "Manual"
+)
+
+// PlanStatus summarizes a plan of changes to the control plane.
+type PlanStatus struct {
+	// Hash identifies the plan, it must be set as value of the approval annotation.
+	Hash string `json:"hash"`
+
+	// ConfigMap is the name of the ConfigMap holding the details of the plan.
+	ConfigMap string `json:"configMap"`
+
+	// Create is the number of objects to be created.
+	Create int `json:"create"`
+
+	// Update is the number of objects to be updated.
+	Update int `json:"update"`
+
+	// Prune is the number of objects to be deleted.
+	Prune int `json:"prune"`
+
+	// Approved reports if the plan has been approved.
+	Approved bool `json:"approved"`
+}
+
+// ChartUpdate is a chart version newer than the installed one.
+type ChartUpdate struct {
+	Version string `json:"version"`
+	Patch   bool   `json:"patch,omitempty"`
+	Minor   bool   `json:"minor,omitempty"`
+	Major   bool   `json:"major,omitempty"`
 }
 
 type Status struct {
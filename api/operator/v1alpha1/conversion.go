@@ -0,0 +1,402 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// SpecAnnotation holds the v1beta1 spec of a resource served as v1alpha1 when the spec
+// carries fields that have no v1alpha1 equivalent, so they are not lost when the resource
+// is updated through the v1alpha1 API.
+const SpecAnnotation = "operator.dapr.io/v1beta1-spec"
+
+// StatusAnnotation holds the fields of the v1beta1 status of a resource served as v1alpha1
+// that have no v1alpha1 equivalent, so they are not lost when the resource is converted back.
+const StatusAnnotation = "operator.dapr.io/v1beta1-status"
+
+var ErrUnsupportedHub = errors.New("unsupported conversion hub")
+
+// daprInstanceStatusStash holds the fields of the v1beta1 DaprInstanceStatus that have no
+// v1alpha1 equivalent.
+// +kubebuilder:object:generate=false
+// +k8s:openapi-gen=false
+type daprInstanceStatusStash struct {
+	ClusterProfile string                `json:"clusterProfile,omitempty"`
+	Sizing         *v1beta1.SizingStatus `json:"sizing,omitempty"`
+}
+
+var (
+	_ conversion.Convertible = &DaprInstance{}
+	_ conversion.Convertible = &DaprControlPlane{}
+	_ conversion.Convertible = &DaprCruiseControl{}
+)
+
+// ConvertTo converts this DaprInstance to the hub version (v1beta1).
+func (in *DaprInstance) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta1.DaprInstance)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedHub, hub)
+	}
+
+	in.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	if err := restore(&dst.ObjectMeta, SpecAnnotation, &dst.Spec); err != nil {
+		return err
+	}
+
+	status := daprInstanceStatusStash{}
+	if err := restore(&dst.ObjectMeta, StatusAnnotation, &status); err != nil {
+		return err
+	}
+
+	convertDaprInstanceSpecTo(&in.Spec, &dst.Spec)
+	convertDaprInstanceStatusTo(&in.Status, &dst.Status)
+
+	dst.Status.ClusterProfile = status.ClusterProfile
+	dst.Status.Sizing = status.Sizing
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this DaprInstance.
+func (in *DaprInstance) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta1.DaprInstance)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedHub, hub)
+	}
+
+	src.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+
+	convertDaprInstanceSpecFrom(&src.Spec, &in.Spec)
+	convertDaprInstanceStatusFrom(&src.Status, &in.Status)
+
+	rt := v1beta1.DaprInstanceSpec{}
+	convertDaprInstanceSpecTo(&in.Spec, &rt)
+
+	if err := stash(&in.ObjectMeta, SpecAnnotation, &src.Spec, &rt); err != nil {
+		return err
+	}
+
+	status := daprInstanceStatusStash{
+		ClusterProfile: src.Status.ClusterProfile,
+		Sizing:         src.Status.Sizing,
+	}
+
+	return stash(&in.ObjectMeta, StatusAnnotation, &status, &daprInstanceStatusStash{})
+}
+
+// ConvertTo converts this DaprControlPlane to the hub version (v1beta1).
+func (in *DaprControlPlane) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta1.DaprControlPlane)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedHub, hub)
+	}
+
+	in.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	if err := restore(&dst.ObjectMeta, SpecAnnotation, &dst.Spec); err != nil {
+		return err
+	}
+
+	dst.Spec.Chart = convertChartSpecTo(in.Spec.Chart)
+	dst.Spec.Values = convertJSONTo(in.Spec.Values)
+
+	convertStatusTo(&in.Status.Status, &dst.Status.Status)
+	dst.Status.Chart = convertChartMetaTo(in.Status.Chart)
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this DaprControlPlane.
+func (in *DaprControlPlane) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta1.DaprControlPlane)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedHub, hub)
+	}
+
+	src.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+
+	in.Spec.Chart = convertChartSpecFrom(src.Spec.Chart)
+	in.Spec.Values = convertJSONFrom(src.Spec.Values)
+
+	convertStatusFrom(&src.Status.Status, &in.Status.Status)
+	in.Status.Chart = convertChartMetaFrom(src.Status.Chart)
+
+	rt := v1beta1.DaprControlPlaneSpec{
+		Chart:  convertChartSpecTo(in.Spec.Chart),
+		Values: convertJSONTo(in.Spec.Values),
+	}
+
+	return stash(&in.ObjectMeta, SpecAnnotation, &src.Spec, &rt)
+}
+
+// ConvertTo converts this DaprCruiseControl to the hub version (v1beta1).
+func (in *DaprCruiseControl) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta1.DaprCruiseControl)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedHub, hub)
+	}
+
+	in.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	if err := restore(&dst.ObjectMeta, SpecAnnotation, &dst.Spec); err != nil {
+		return err
+	}
+
+	convertStatusTo(&in.Status.Status, &dst.Status.Status)
+	dst.Status.Chart = convertChartMetaTo(in.Status.Chart)
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this DaprCruiseControl.
+func (in *DaprCruiseControl) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta1.DaprCruiseControl)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedHub, hub)
+	}
+
+	src.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+
+	convertStatusFrom(&src.Status.Status, &in.Status.Status)
+	in.Status.Chart = convertChartMetaFrom(src.Status.Chart)
+
+	return stash(&in.ObjectMeta, SpecAnnotation, &src.Spec, &v1beta1.DaprCruiseControlSpec{})
+}
+
+// stash stores the given v1beta1 value in the given annotation if it differs from the one
+// obtained by converting the v1alpha1 value back, that is when some of its fields cannot be
+// represented in v1alpha1.
+func stash(om *metav1.ObjectMeta, annotation string, value any, roundTrip any) error {
+	annotations := om.GetAnnotations()
+	delete(annotations, annotation)
+
+	if !equality.Semantic.DeepEqual(value, roundTrip) {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("unable to marshal %s annotation: %w", annotation, err)
+		}
+
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+
+		annotations[annotation] = string(data)
+	}
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+
+	om.SetAnnotations(annotations)
+
+	return nil
+}
+
+// restore restores the v1beta1 value stored in the given annotation, if any, the fields that
+// have a v1alpha1 equivalent are then expected to be overridden.
+func restore(om *metav1.ObjectMeta, annotation string, value any) error {
+	annotations := om.GetAnnotations()
+
+	data, ok := annotations[annotation]
+	if !ok {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(data), value); err != nil {
+		return fmt.Errorf("unable to unmarshal %s annotation: %w", annotation, err)
+	}
+
+	delete(annotations, annotation)
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+
+	om.SetAnnotations(annotations)
+
+	return nil
+}
+
+func convertDaprInstanceSpecTo(src *DaprInstanceSpec, dst *v1beta1.DaprInstanceSpec) {
+	dst.Chart = convertChartSpecTo(src.Chart)
+	dst.Values = convertJSONTo(src.Values)
+	dst.ApprovalPolicy = v1beta1.ApprovalPolicy(src.ApprovalPolicy)
+	dst.MaintenanceWindows = nil
+
+	for _, w := range src.MaintenanceWindows {
+		dst.MaintenanceWindows = append(dst.MaintenanceWindows, v1beta1.MaintenanceWindow{
+			Schedule: w.Schedule,
+			Duration: w.Duration,
+			TimeZone: w.TimeZone,
+		})
+	}
+}
+
+func convertDaprInstanceSpecFrom(src *v1beta1.DaprInstanceSpec, dst *DaprInstanceSpec) {
+	dst.Chart = convertChartSpecFrom(src.Chart)
+	dst.Values = convertJSONFrom(src.Values)
+	dst.ApprovalPolicy = ApprovalPolicy(src.ApprovalPolicy)
+	dst.MaintenanceWindows = nil
+
+	for _, w := range src.MaintenanceWindows {
+		dst.MaintenanceWindows = append(dst.MaintenanceWindows, MaintenanceWindow{
+			Schedule: w.Schedule,
+			Duration: w.Duration,
+			TimeZone: w.TimeZone,
+		})
+	}
+}
+
+func convertDaprInstanceStatusTo(src *DaprInstanceStatus, dst *v1beta1.DaprInstanceStatus) {
+	convertStatusTo(&src.Status, &dst.Status)
+
+	dst.Chart = convertChartMetaTo(src.Chart)
+	dst.WorkloadsDigest = src.WorkloadsDigest
+	dst.AvailableUpdates = nil
+	dst.Plan = nil
+
+	for _, u := range src.AvailableUpdates {
+		dst.AvailableUpdates = append(dst.AvailableUpdates, v1beta1.ChartUpdate(u))
+	}
+
+	if src.Plan != nil {
+		plan := v1beta1.PlanStatus(*src.Plan)
+		dst.Plan = &plan
+	}
+}
+
+func convertDaprInstanceStatusFrom(src *v1beta1.DaprInstanceStatus, dst *DaprInstanceStatus) {
+	convertStatusFrom(&src.Status, &dst.Status)
+
+	dst.Chart = convertChartMetaFrom(src.Chart)
+	dst.WorkloadsDigest = src.WorkloadsDigest
+	dst.AvailableUpdates = nil
+	dst.Plan = nil
+
+	for _, u := range src.AvailableUpdates {
+		dst.AvailableUpdates = append(dst.AvailableUpdates, ChartUpdate(u))
+	}
+
+	if src.Plan != nil {
+		plan := PlanStatus(*src.Plan)
+		dst.Plan = &plan
+	}
+}
+
+func convertStatusTo(src *Status, dst *v1beta1.Status) {
+	dst.Phase = src.Phase
+	dst.ObservedGeneration = src.ObservedGeneration
+	dst.Conditions = append([]metav1.Condition(nil), src.Conditions...)
+}
+
+func convertStatusFrom(src *v1beta1.Status, dst *Status) {
+	dst.Phase = src.Phase
+	dst.ObservedGeneration = src.ObservedGeneration
+	dst.Conditions = append([]metav1.Condition(nil), src.Conditions...)
+}
+
+func convertChartSpecTo(src *ChartSpec) *v1beta1.ChartSpec {
+	if src == nil {
+		return nil
+	}
+
+	dst := v1beta1.ChartSpec{
+		Repo:    src.Repo,
+		Name:    src.Name,
+		Version: src.Version,
+		Secret:  src.Secret,
+	}
+
+	if src.ResolveInterval != nil {
+		dst.ResolveInterval = src.ResolveInterval.DeepCopy()
+	}
+
+	return &dst
+}
+
+func convertChartSpecFrom(src *v1beta1.ChartSpec) *ChartSpec {
+	if src == nil {
+		return nil
+	}
+
+	dst := ChartSpec{
+		Repo:    src.Repo,
+		Name:    src.Name,
+		Version: src.Version,
+		Secret:  src.Secret,
+	}
+
+	if src.ResolveInterval != nil {
+		dst.ResolveInterval = src.ResolveInterval.DeepCopy()
+	}
+
+	return &dst
+}
+
+func convertChartMetaTo(src *ChartMeta) *v1beta1.ChartMeta {
+	if src == nil {
+		return nil
+	}
+
+	dst := v1beta1.ChartMeta{
+		Repo:       src.Repo,
+		Name:       src.Name,
+		Version:    src.Version,
+		Constraint: src.Constraint,
+	}
+
+	if src.ResolvedAt != nil {
+		dst.ResolvedAt = src.ResolvedAt.DeepCopy()
+	}
+
+	return &dst
+}
+
+func convertChartMetaFrom(src *v1beta1.ChartMeta) *ChartMeta {
+	if src == nil {
+		return nil
+	}
+
+	dst := ChartMeta{
+		Repo:       src.Repo,
+		Name:       src.Name,
+		Version:    src.Version,
+		Constraint: src.Constraint,
+	}
+
+	if src.ResolvedAt != nil {
+		dst.ResolvedAt = src.ResolvedAt.DeepCopy()
+	}
+
+	return &dst
+}
+
+func convertJSONTo(src *JSON) *v1beta1.JSON {
+	if src == nil {
+		return nil
+	}
+
+	dst := v1beta1.JSON{}
+	dst.RawMessage = append(v1beta1.RawMessage(nil), src.RawMessage...)
+
+	return &dst
+}
+
+func convertJSONFrom(src *v1beta1.JSON) *JSON {
+	if src == nil {
+		return nil
+	}
+
+	dst := JSON{}
+	dst.RawMessage = append(RawMessage(nil), src.RawMessage...)
+
+	return &dst
+}
@@ -0,0 +1,235 @@
+package v1alpha1_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	"github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDaprInstanceRoundTripFromV1Alpha1(t *testing.T) {
+	tests := []struct {
+		name string
+		spec v1alpha1.DaprInstanceSpec
+	}{
+		{
+			name: "empty",
+			spec: v1alpha1.DaprInstanceSpec{},
+		},
+		{
+			name: "chart and values",
+			spec: v1alpha1.DaprInstanceSpec{
+				Chart: &v1alpha1.ChartSpec{
+					Repo:            "https://dapr.github.io/helm-charts",
+					Name:            "dapr",
+					Version:         "~1.16.0",
+					Secret:          "chart-credentials",
+					ResolveInterval: &metav1.Duration{Duration: time.Hour},
+				},
+				Values: &v1alpha1.JSON{
+					RawMessage: v1alpha1.RawMessage(`{"global":{"ha":{"enabled":true}}}`),
+				},
+			},
+		},
+		{
+			name: "maintenance and approval",
+			spec: v1alpha1.DaprInstanceSpec{
+				MaintenanceWindows: []v1alpha1.MaintenanceWindow{{
+					Schedule: "0 2 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+					TimeZone: "Europe/Rome",
+				}},
+				ApprovalPolicy: v1alpha1.ApprovalPolicyManual,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			in := v1alpha1.DaprInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+				Spec:       tt.spec,
+			}
+
+			hub := v1beta1.DaprInstance{}
+			g.Expect(in.ConvertTo(&hub)).To(Succeed())
+
+			out := v1alpha1.DaprInstance{}
+			g.Expect(out.ConvertFrom(&hub)).To(Succeed())
+
+			g.Expect(out.ObjectMeta).To(Equal(in.ObjectMeta))
+			g.Expect(out.Spec).To(Equal(in.Spec))
+		})
+	}
+}
+
+func TestDaprInstanceRoundTripFromV1Beta1(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v1beta1.DaprInstanceSpec
+		stashed bool
+	}{
+		{
+			name: "representable in v1alpha1",
+			spec: v1beta1.DaprInstanceSpec{
+				Chart: &v1beta1.ChartSpec{
+					Version: "1.16.1",
+				},
+				ApprovalPolicy: v1beta1.ApprovalPolicyManual,
+			},
+			stashed: false,
+		},
+		{
+			name: "v1beta1 only fields",
+			spec: v1beta1.DaprInstanceSpec{
+				Chart: &v1beta1.ChartSpec{
+					Version: "1.16.1",
+				},
+				Profile:          v1beta1.SizingProfileSmall,
+				ValuesTransforms: []string{`.global.ha.enabled = true`},
+				CommonLabels:     map[string]string{"team": "platform"},
+			},
+			stashed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			in := v1beta1.DaprInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+				Spec:       tt.spec,
+			}
+
+			spoke := v1alpha1.DaprInstance{}
+			g.Expect(spoke.ConvertFrom(in.DeepCopy())).To(Succeed())
+
+			_, stashed := spoke.Annotations[v1alpha1.SpecAnnotation]
+			g.Expect(stashed).To(Equal(tt.stashed))
+
+			out := v1beta1.DaprInstance{}
+			g.Expect(spoke.ConvertTo(&out)).To(Succeed())
+
+			g.Expect(out.ObjectMeta).To(Equal(in.ObjectMeta))
+			g.Expect(out.Spec).To(Equal(in.Spec))
+		})
+	}
+}
+
+func TestDaprInstanceStatusRoundTripFromV1Beta1(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  v1beta1.DaprInstanceStatus
+		stashed bool
+	}{
+		{
+			name: "representable in v1alpha1",
+			status: v1beta1.DaprInstanceStatus{
+				Status: v1beta1.Status{
+					Phase:              "Ready",
+					ObservedGeneration: 1,
+				},
+				WorkloadsDigest: "digest",
+			},
+			stashed: false,
+		},
+		{
+			name: "v1beta1 only fields",
+			status: v1beta1.DaprInstanceStatus{
+				Status: v1beta1.Status{
+					Phase:              "Ready",
+					ObservedGeneration: 1,
+				},
+				WorkloadsDigest: "digest",
+				ClusterProfile:  "OpenShift",
+				Sizing: &v1beta1.SizingStatus{
+					Profile: v1beta1.SizingProfileMedium,
+					Workloads: []v1beta1.WorkloadSizing{
+						{Kind: "Deployment", Name: "dapr-operator"},
+					},
+				},
+			},
+			stashed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			in := v1beta1.DaprInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+				Status:     tt.status,
+			}
+
+			spoke := v1alpha1.DaprInstance{}
+			g.Expect(spoke.ConvertFrom(in.DeepCopy())).To(Succeed())
+
+			_, stashed := spoke.Annotations[v1alpha1.StatusAnnotation]
+			g.Expect(stashed).To(Equal(tt.stashed))
+			g.Expect(spoke.Annotations).ToNot(HaveKey(v1alpha1.SpecAnnotation))
+
+			out := v1beta1.DaprInstance{}
+			g.Expect(spoke.ConvertTo(&out)).To(Succeed())
+
+			g.Expect(out.ObjectMeta).To(Equal(in.ObjectMeta))
+			g.Expect(out.Status).To(Equal(in.Status))
+		})
+	}
+}
+
+func TestDaprInstanceV1Alpha1UpdateOverridesStashedSpec(t *testing.T) {
+	g := NewWithT(t)
+
+	in := v1beta1.DaprInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+		Spec: v1beta1.DaprInstanceSpec{
+			Chart:   &v1beta1.ChartSpec{Version: "1.16.0"},
+			Profile: v1beta1.SizingProfileMedium,
+		},
+	}
+
+	spoke := v1alpha1.DaprInstance{}
+	g.Expect(spoke.ConvertFrom(&in)).To(Succeed())
+
+	// the version is changed through the v1alpha1 API
+	spoke.Spec.Chart.Version = "1.16.1"
+
+	out := v1beta1.DaprInstance{}
+	g.Expect(spoke.ConvertTo(&out)).To(Succeed())
+
+	g.Expect(out.Spec.Chart.Version).To(Equal("1.16.1"))
+	g.Expect(out.Spec.Profile).To(Equal(v1beta1.SizingProfileMedium))
+	g.Expect(out.Annotations).ToNot(HaveKey(v1alpha1.SpecAnnotation))
+}
+
+func TestDaprControlPlaneRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	in := v1alpha1.DaprControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "dapr-control-plane", Namespace: "dapr-system"},
+		Spec: v1alpha1.DaprControlPlaneSpec{
+			Chart: &v1alpha1.ChartSpec{Version: "1.16.1"},
+			Values: &v1alpha1.JSON{
+				RawMessage: v1alpha1.RawMessage(`{"dapr_operator":{"replicaCount":2}}`),
+			},
+		},
+	}
+
+	hub := v1beta1.DaprControlPlane{}
+	g.Expect(in.ConvertTo(&hub)).To(Succeed())
+
+	out := v1alpha1.DaprControlPlane{}
+	g.Expect(out.ConvertFrom(&hub)).To(Succeed())
+
+	g.Expect(out.ObjectMeta).To(Equal(in.ObjectMeta))
+	g.Expect(out.Spec).To(Equal(in.Spec))
+}
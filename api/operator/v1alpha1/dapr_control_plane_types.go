@@ -22,6 +22,9 @@ import (
 )
 
 type DaprControlPlaneSpec struct {
+	// +kubebuilder:validation:Optional
+	Chart *ChartSpec `json:"chart,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	Values *JSON `json:"values"`
 }
@@ -35,7 +38,6 @@ type DaprControlPlaneStatus struct {
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 // +kubebuilder:printcolumn:name="Chart Name",type=string,JSONPath=`.status.chart.name`,description="Chart Name"
@@ -28,6 +28,18 @@ type DaprInstanceSpec struct {
 
 	// +kubebuilder:validation:Optional
 	Values *JSON `json:"values"`
+
+	// MaintenanceWindows restricts when changes to the chart version or to the control plane
+	// workloads are applied, changes requested outside a window are held until the next one.
+	// +kubebuilder:validation:Optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// ApprovalPolicy defines if changes to the control plane are applied automatically or
+	// only once the related plan has been approved.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Automatic;Manual
+	// +kubebuilder:default=Automatic
+	ApprovalPolicy ApprovalPolicy `json:"approvalPolicy,omitempty"`
 }
 
 // DaprInstanceStatus defines the observed state of DaprInstance.
@@ -35,12 +47,21 @@ type DaprInstanceStatus struct {
 	Status `json:",inline"`
 
 	Chart *ChartMeta `json:"chart,omitempty"`
+
+	// AvailableUpdates lists the versions of the chart published in the repository
+	// that are newer than the installed one.
+	AvailableUpdates []ChartUpdate `json:"availableUpdates,omitempty"`
+
+	// WorkloadsDigest is the digest of the control plane workloads last applied.
+	WorkloadsDigest string `json:"workloadsDigest,omitempty"`
+
+	// Plan is the latest plan computed when the approval policy is Manual.
+	Plan *PlanStatus `json:"plan,omitempty"`
 }
 
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
 // +kubebuilder:printcolumn:name="Chart Name",type=string,JSONPath=`.status.chart.name`,description="Chart Name"
@@ -28,6 +28,10 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartMeta) DeepCopyInto(out *ChartMeta) {
 	*out = *in
+	if in.ResolvedAt != nil {
+		in, out := &in.ResolvedAt, &out.ResolvedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartMeta.
@@ -43,6 +47,11 @@ func (in *ChartMeta) DeepCopy() *ChartMeta {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartSpec) DeepCopyInto(out *ChartSpec) {
 	*out = *in
+	if in.ResolveInterval != nil {
+		in, out := &in.ResolveInterval, &out.ResolveInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartSpec.
@@ -55,6 +64,21 @@ func (in *ChartSpec) DeepCopy() *ChartSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartUpdate) DeepCopyInto(out *ChartUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartUpdate.
+func (in *ChartUpdate) DeepCopy() *ChartUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaprControlPlane) DeepCopyInto(out *DaprControlPlane) {
 	*out = *in
@@ -117,6 +141,11 @@ func (in *DaprControlPlaneList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaprControlPlaneSpec) DeepCopyInto(out *DaprControlPlaneSpec) {
 	*out = *in
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(ChartSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Values != nil {
 		in, out := &in.Values, &out.Values
 		*out = new(JSON)
@@ -141,7 +170,7 @@ func (in *DaprControlPlaneStatus) DeepCopyInto(out *DaprControlPlaneStatus) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartMeta)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -236,7 +265,7 @@ func (in *DaprCruiseControlStatus) DeepCopyInto(out *DaprCruiseControlStatus) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartMeta)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -315,13 +344,18 @@ func (in *DaprInstanceSpec) DeepCopyInto(out *DaprInstanceSpec) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Values != nil {
 		in, out := &in.Values, &out.Values
 		*out = new(JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaprInstanceSpec.
@@ -341,6 +375,16 @@ func (in *DaprInstanceStatus) DeepCopyInto(out *DaprInstanceStatus) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartMeta)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailableUpdates != nil {
+		in, out := &in.AvailableUpdates, &out.AvailableUpdates
+		*out = make([]ChartUpdate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
 		**out = **in
 	}
 }
@@ -375,6 +419,37 @@ func (in *JSON) DeepCopy() *JSON {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in RawMessage) DeepCopyInto(out *RawMessage) {
 	{
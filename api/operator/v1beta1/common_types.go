@@ -0,0 +1,438 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ErrUnmarshalOnNil = errors.New("UnmarshalJSON on nil pointer")
+
+// RawMessage is a raw encoded JSON value.
+// It implements Marshaler and Unmarshaler and can
+// be used to delay JSON decoding or precompute a JSON encoding.
+// +kubebuilder:validation:Type=""
+// +kubebuilder:validation:Format=""
+// +kubebuilder:pruning:PreserveUnknownFields
+type RawMessage []byte
+
+// +kubebuilder:validation:Type=""
+// JSON represents any valid JSON value.
+// These types are supported: bool, int64, float64, string, []interface{}, map[string]interface{} and nil.
+type JSON struct {
+	RawMessage `json:",inline"`
+}
+
+// MarshalJSON returns m as the JSON encoding of m.
+func (m RawMessage) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	return m, nil
+}
+
+// UnmarshalJSON sets *m to a copy of data.
+func (m *RawMessage) UnmarshalJSON(data []byte) error {
+	if m == nil {
+		return fmt.Errorf("json.RawMessage: %w", ErrUnmarshalOnNil)
+	}
+
+	*m = append((*m)[0:0], data...)
+
+	return nil
+}
+
+// String returns a string representation of RawMessage.
+func (m *RawMessage) String() string {
+	if m == nil {
+		return ""
+	}
+
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+var _ json.Marshaler = (*RawMessage)(nil)
+var _ json.Unmarshaler = (*RawMessage)(nil)
+
+type ChartSpec struct {
+	// +kubebuilder:default:="https://dapr.github.io/helm-charts"
+	Repo string `json:"repo,omitempty"`
+
+	// +kubebuilder:default:="dapr"
+	Name string `json:"name,omitempty"`
+
+	// Version is either an exact chart version or a semver constraint such as ~1.16.0
+	// or ">=1.15 <1.17". Constraints are periodically resolved against the repository
+	// so new matching versions get applied automatically.
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Secret string `json:"secret,omitempty"`
+
+	// ResolveInterval is how often the repository is queried to resolve a version
+	// constraint and to look for available updates, defaults to 1h.
+	// +kubebuilder:validation:Optional
+	ResolveInterval *metav1.Duration `json:"resolveInterval,omitempty"`
+}
+
+type ChartMeta struct {
+	Repo    string `json:"repo,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+
+	// Constraint is the version constraint the version has been resolved from.
+	Constraint string `json:"constraint,omitempty"`
+
+	// ResolvedAt is the last time the version constraint has been resolved.
+	ResolvedAt *metav1.Time `json:"resolvedAt,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window in which changes to the control plane can be applied.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression defining when the window opens, i.e. "0 2 * * SAT".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open, at least one minute as the schedule has a
+	// granularity of one minute.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="duration must be at least 1m"
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the name of the IANA time zone the schedule is evaluated in, defaults to UTC.
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ApprovalPolicy defines how changes to the control plane are approved.
+type ApprovalPolicy string
+
+const (
+	// ApprovalPolicyAutomatic applies changes as soon as they are requested.
+	ApprovalPolicyAutomatic ApprovalPolicy = "Automatic"
+	// ApprovalPolicyManual applies changes only once the related plan has been approved.
+	ApprovalPolicyManual ApprovalPolicy = "Manual"
+)
+
+// PlanStatus summarizes a plan of changes to the control plane.
+type PlanStatus struct {
+	// Hash identifies the plan, it must be set as value of the approval annotation.
+	Hash string `json:"hash"`
+
+	// ConfigMap is the name of the ConfigMap holding the details of the plan.
+	ConfigMap string `json:"configMap"`
+
+	// Create is the number of objects to be created.
+	Create int `json:"create"`
+
+	// Update is the number of objects to be updated.
+	Update int `json:"update"`
+
+	// Prune is the number of objects to be deleted.
+	Prune int `json:"prune"`
+
+	// Approved reports if the plan has been approved.
+	Approved bool `json:"approved"`
+}
+
+// ChartUpdate is a chart version newer than the installed one.
+type ChartUpdate struct {
+	Version string `json:"version"`
+	Patch   bool   `json:"patch,omitempty"`
+	Minor   bool   `json:"minor,omitempty"`
+	Major   bool   `json:"major,omitempty"`
+}
+
+type Status struct {
+	Phase              string             `json:"phase"`
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+}
+
+// ClusterProfileSpec configures the profiles applied to the rendered resources according to
+// the type of the cluster.
+type ClusterProfileSpec struct {
+	// OpenShift configures the profile applied when running on OpenShift.
+	// +kubebuilder:validation:Optional
+	OpenShift *OpenShiftProfileSpec `json:"openShift,omitempty"`
+}
+
+// OpenShiftProfileSpec configures the profile applied when running on OpenShift.
+type OpenShiftProfileSpec struct {
+	// DashboardRoute, if set, exposes the Dapr dashboard rendered by the chart through
+	// an OpenShift Route.
+	// +kubebuilder:validation:Optional
+	DashboardRoute *RouteSpec `json:"dashboardRoute,omitempty"`
+}
+
+// RouteSpec configures an OpenShift Route.
+type RouteSpec struct {
+	// Host is the host the Route is exposed on, generated by the router if not set.
+	// +kubebuilder:validation:Optional
+	Host string `json:"host,omitempty"`
+}
+
+// PodSecurityLevel is a level of the Pod Security Standards.
+type PodSecurityLevel string
+
+const (
+	// PodSecurityLevelBaseline prevents known privilege escalations.
+	PodSecurityLevelBaseline PodSecurityLevel = "baseline"
+	// PodSecurityLevelRestricted follows the current pod hardening best practices.
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+)
+
+// SecuritySpec configures the security settings enforced on the control plane workloads.
+type SecuritySpec struct {
+	// PodSecurity is the Pod Security Standards level the pod templates of the control plane
+	// workloads are adjusted to, the rendered workloads that do not comply with the level
+	// are not applied. When not set, the pod templates are neither adjusted nor validated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=baseline;restricted
+	PodSecurity PodSecurityLevel `json:"podSecurity,omitempty"`
+}
+
+// PatchType is the type of a patch.
+type PatchType string
+
+const (
+	// PatchTypeStrategicMerge is a Kubernetes strategic merge patch, resources whose type
+	// is not known to the operator, such as custom resources, are patched with a JSON merge
+	// patch instead.
+	PatchTypeStrategicMerge PatchType = "StrategicMerge"
+	// PatchTypeJSON6902 is a list of RFC 6902 JSON patch operations.
+	PatchTypeJSON6902 PatchType = "JSON6902"
+)
+
+// PatchTarget selects the rendered resources a patch applies to, a resource is selected if
+// it matches all the fields that are set.
+type PatchTarget struct {
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Kind string `json:"kind,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// LabelSelector is a label selector in its string form, i.e. "app=dapr-operator".
+	// +kubebuilder:validation:Optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
+// Patch is a patch applied to the rendered resources selected by its target.
+type Patch struct {
+	// +kubebuilder:validation:Required
+	Target PatchTarget `json:"target"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=StrategicMerge;JSON6902
+	// +kubebuilder:default=StrategicMerge
+	Type PatchType `json:"type,omitempty"`
+
+	// Patch is the patch document, in YAML or JSON.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+}
+
+// SchedulingSpec configures how the pods of a workload are scheduled, the fields that are set
+// replace the ones rendered by the chart.
+type SchedulingSpec struct {
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
+
+// PlacementSpec configures how the pods of the control plane workloads are scheduled.
+type PlacementSpec struct {
+	// The scheduling settings applied to all the control plane workloads.
+	SchedulingSpec `json:",inline"`
+
+	// Components overrides the scheduling settings of specific components, keyed by the
+	// value of their app.kubernetes.io/component label, i.e. operator, placement, scheduler,
+	// sentry or sidecar-injector. The fields that are set replace the ones set for all the
+	// workloads.
+	// +kubebuilder:validation:Optional
+	Components map[string]SchedulingSpec `json:"components,omitempty"`
+}
+
+// SizingProfile is a predefined sizing of the control plane workloads.
+type SizingProfile string
+
+const (
+	// SizingProfileDev runs a single replica of each component with minimal resources, but
+	// the scheduler, which always runs the 3 replicas set by the chart.
+	SizingProfileDev SizingProfile = "dev"
+	// SizingProfileSmall runs a single replica of each component, but the scheduler.
+	SizingProfileSmall SizingProfile = "small"
+	// SizingProfileMedium runs the control plane in high availability.
+	SizingProfileMedium SizingProfile = "medium"
+	// SizingProfileLarge runs the control plane in high availability, with more replicas
+	// and resources.
+	SizingProfileLarge SizingProfile = "large"
+	// SizingProfileCustom leaves the sizing to the chart values.
+	SizingProfileCustom SizingProfile = "custom"
+)
+
+// SizingStatus reports the sizing profile and the effective sizing of the control plane
+// workloads.
+type SizingStatus struct {
+	// +kubebuilder:validation:Optional
+	Profile SizingProfile `json:"profile,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Workloads []WorkloadSizing `json:"workloads,omitempty"`
+}
+
+// WorkloadSizing is the effective sizing of a control plane workload.
+type WorkloadSizing struct {
+	// Kind is the kind of the workload, i.e. Deployment or StatefulSet.
+	Kind string `json:"kind"`
+
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Containers []ContainerSizing `json:"containers,omitempty"`
+
+	// StorageSize is the size of the volumes requested by the volume claim templates of a
+	// StatefulSet.
+	// +kubebuilder:validation:Optional
+	StorageSize map[string]resource.Quantity `json:"storageSize,omitempty"`
+}
+
+// ContainerSizing is the effective sizing of a container of a control plane workload.
+type ContainerSizing struct {
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// AutoscalingSpec configures the autoscaling of the stateless control plane components.
+type AutoscalingSpec struct {
+	// +kubebuilder:validation:Optional
+	Operator *ComponentAutoscalingSpec `json:"operator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	SidecarInjector *ComponentAutoscalingSpec `json:"sidecarInjector,omitempty"`
+}
+
+// ComponentAutoscalingSpec configures the HorizontalPodAutoscaler of a control plane
+// component. When no target is set, the default target of the HorizontalPodAutoscaler, an
+// average CPU utilization of 80%, applies.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be lower than or equal to maxReplicas"
+type ComponentAutoscalingSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization, relative to the
+	// requested CPU of the pods.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage is the target average memory utilization, relative
+	// to the requested memory of the pods.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// PodDisruptionBudgetPolicy defines how the PodDisruptionBudgets of the control plane
+// workloads are managed.
+type PodDisruptionBudgetPolicy string
+
+const (
+	// PodDisruptionBudgetPolicyManaged replaces the PodDisruptionBudgets rendered by the chart
+	// with the ones managed by the operator.
+	PodDisruptionBudgetPolicyManaged PodDisruptionBudgetPolicy = "Managed"
+	// PodDisruptionBudgetPolicyChart leaves the PodDisruptionBudgets to the chart values.
+	PodDisruptionBudgetPolicyChart PodDisruptionBudgetPolicy = "Chart"
+)
+
+// AvailabilitySpec configures the availability of the control plane workloads.
+type AvailabilitySpec struct {
+	// PodDisruptionBudgets defines how the PodDisruptionBudgets are managed. When Managed, a
+	// PodDisruptionBudget allowing one unavailable pod is created for each workload running
+	// in high availability or with more than one replica, in place of the ones rendered by
+	// the chart. When Chart, they are left to the chart values.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Managed;Chart
+	// +kubebuilder:default=Managed
+	PodDisruptionBudgets PodDisruptionBudgetPolicy `json:"podDisruptionBudgets,omitempty"`
+
+	// PriorityClass, if set, is created and assigned to the control plane workloads which
+	// are not assigned one through the placement.
+	// +kubebuilder:validation:Optional
+	PriorityClass *PriorityClassSpec `json:"priorityClass,omitempty"`
+}
+
+// PriorityClassSpec configures the PriorityClass of the control plane workloads.
+type PriorityClassSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=dapr-control-plane
+	Name string `json:"name,omitempty"`
+
+	// Value is the priority of the pods, higher values are reserved to the system critical
+	// pods. As the value of a PriorityClass is immutable, changing it re-creates the
+	// PriorityClass, the running pods keep their priority until they are re-created.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Maximum=1000000000
+	// +kubebuilder:default=1000000
+	Value int32 `json:"value,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+}
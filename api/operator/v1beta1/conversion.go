@@ -0,0 +1,10 @@
+package v1beta1
+
+// Hub marks this type as a conversion hub.
+func (*DaprInstance) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*DaprControlPlane) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*DaprCruiseControl) Hub() {}
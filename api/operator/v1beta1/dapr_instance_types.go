@@ -0,0 +1,176 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DaprInstanceSpec defines the desired state of DaprInstance.
+type DaprInstanceSpec struct {
+	// +kubebuilder:validation:Optional
+	Chart *ChartSpec `json:"chart,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Values *JSON `json:"values"`
+
+	// ValuesTransforms are jq expressions evaluated, in order, against the values before
+	// rendering the chart. Each expression gets the result of the previous one and must
+	// produce an object, which replaces the values.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:MinLength=1
+	ValuesTransforms []string `json:"valuesTransforms,omitempty"`
+
+	// MaintenanceWindows restricts when changes to the chart version or to the control plane
+	// workloads are applied, changes requested outside a window are held until the next one.
+	// +kubebuilder:validation:Optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// ApprovalPolicy defines if changes to the control plane are applied automatically or
+	// only once the related plan has been approved.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Automatic;Manual
+	// +kubebuilder:default=Automatic
+	ApprovalPolicy ApprovalPolicy `json:"approvalPolicy,omitempty"`
+
+	// ClusterProfile configures the adjustments made to the rendered resources according to
+	// the type of the cluster the control plane runs on, which is detected by the operator.
+	// +kubebuilder:validation:Optional
+	ClusterProfile *ClusterProfileSpec `json:"clusterProfile,omitempty"`
+
+	// Security configures the security settings enforced on the control plane workloads.
+	// +kubebuilder:validation:Optional
+	Security *SecuritySpec `json:"security,omitempty"`
+
+	// Patches are applied, in order, to the resources rendered by the chart. They allow to
+	// change what the chart values do not expose.
+	// +kubebuilder:validation:Optional
+	Patches []Patch `json:"patches,omitempty"`
+
+	// Profile is a predefined sizing of the control plane workloads, which sets the replicas,
+	// the resources and the storage size of the components. The profile is merged with the
+	// values, which take precedence. The custom profile, as well as no profile, leaves the
+	// sizing to the values.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=dev;small;medium;large;custom
+	Profile SizingProfile `json:"profile,omitempty"`
+
+	// Autoscaling configures the HorizontalPodAutoscalers of the stateless control plane
+	// components, the replicas of the autoscaled Deployments are then left to them.
+	// +kubebuilder:validation:Optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// Availability configures the PodDisruptionBudgets and the PriorityClass of the control
+	// plane workloads.
+	// +kubebuilder:validation:Optional
+	Availability *AvailabilitySpec `json:"availability,omitempty"`
+
+	// Placement configures how the pods of the control plane workloads are scheduled, i.e.
+	// to pin them to dedicated nodes.
+	// +kubebuilder:validation:Optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+
+	// CommonLabels are added to all the rendered resources and to the pods of the control
+	// plane workloads. Labels set by the chart take precedence.
+	// +kubebuilder:validation:Optional
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+
+	// CommonAnnotations are added to all the rendered resources and to the pods of the
+	// control plane workloads. Annotations set by the chart take precedence.
+	// +kubebuilder:validation:Optional
+	CommonAnnotations map[string]string `json:"commonAnnotations,omitempty"`
+
+	// PodLabels are added to the pods of the control plane workloads, they take precedence
+	// over the common labels. Labels set by the chart take precedence.
+	// +kubebuilder:validation:Optional
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+
+	// PodAnnotations are added to the pods of the control plane workloads, they take
+	// precedence over the common annotations. Annotations set by the chart take precedence.
+	// +kubebuilder:validation:Optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+}
+
+// DaprInstanceStatus defines the observed state of DaprInstance.
+type DaprInstanceStatus struct {
+	Status `json:",inline"`
+
+	Chart *ChartMeta `json:"chart,omitempty"`
+
+	// AvailableUpdates lists the versions of the chart published in the repository
+	// that are newer than the installed one.
+	AvailableUpdates []ChartUpdate `json:"availableUpdates,omitempty"`
+
+	// WorkloadsDigest is the digest of the control plane workloads last applied.
+	WorkloadsDigest string `json:"workloadsDigest,omitempty"`
+
+	// Plan is the latest plan computed when the approval policy is Manual.
+	Plan *PlanStatus `json:"plan,omitempty"`
+
+	// ClusterProfile is the profile applied to the rendered resources, as detected from the
+	// type of the cluster, i.e. Vanilla or OpenShift.
+	ClusterProfile string `json:"clusterProfile,omitempty"`
+
+	// Sizing is the sizing profile and the effective sizing of the control plane workloads
+	// last applied.
+	Sizing *SizingStatus `json:"sizing,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Chart Name",type=string,JSONPath=`.status.chart.name`,description="Chart Name"
+// +kubebuilder:printcolumn:name="Chart Repo",type=string,JSONPath=`.status.chart.repo`,description="Chart Repo"
+// +kubebuilder:printcolumn:name="Chart Version",type=string,JSONPath=`.status.chart.version`,description="Chart Version"
+// +kubebuilder:printcolumn:name="Profile",type=string,JSONPath=`.status.clusterProfile`,description="Cluster Profile",priority=1
+// +kubebuilder:printcolumn:name="Sizing",type=string,JSONPath=`.status.sizing.profile`,description="Sizing Profile",priority=1
+// +kubebuilder:resource:path=daprinstances,scope=Namespaced,shortName=di,categories=dapr
+
+// DaprInstance is the Schema for the daprinstances API.
+type DaprInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DaprInstanceSpec   `json:"spec,omitempty"`
+	Status DaprInstanceStatus `json:"status,omitempty"`
+}
+
+func (in *DaprInstance) GetStatus() *Status {
+	return &in.Status.Status
+}
+
+func (in *DaprInstance) GetConditions() conditions.Conditions {
+	return in.Status.Conditions
+}
+
+// +kubebuilder:object:root=true
+
+// DaprInstanceList contains a list of DaprInstance.
+type DaprInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DaprInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DaprInstance{}, &DaprInstanceList{})
+}
@@ -0,0 +1,7 @@
+// Package v1beta1 contains API Schema definitions for the operator.dapr.io API group.
+//
+// +k8s:openapi-gen=true
+// +kubebuilder:object:generate=true
+// +groupName=operator.dapr.io
+
+package v1beta1
@@ -0,0 +1,331 @@
+package diff
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"github.com/wI2L/jsondiff"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/cmd/render"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	cmdName = "diff"
+
+	OutputUnified   = "unified"
+	OutputJSONPatch = "json-patch"
+
+	// ExitCodeChanges is returned when the live objects differ from the desired ones.
+	ExitCodeChanges = 1
+	// ExitCodeFailure is returned when the diff cannot be computed.
+	ExitCodeFailure = 2
+)
+
+var (
+	ErrChangesDetected   = errors.New("changes detected")
+	ErrUnsupportedOutput = errors.New("unsupported output")
+)
+
+// ExitError carries the exit code the command must terminate with.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ExitError) ExitCode() int {
+	return e.Code
+}
+
+func failure(err error) error {
+	return &ExitError{Code: ExitCodeFailure, Err: err}
+}
+
+// clientFactory creates a client for the given kubeconfig file and context, returning the
+// namespace of the context too.
+type clientFactory func(kubeconfig string, context string, scheme *runtime.Scheme) (*client.Client, string, error)
+
+func NewCmd() *cobra.Command {
+	return newCmd(client.NewClientForKubeconfig)
+}
+
+func newCmd(newClient clientFactory) *cobra.Command {
+	file := "-"
+	namespace := ""
+	kubeconfig := ""
+	kubecontext := ""
+	output := OutputUnified
+
+	helmOpts := helm.Options{
+		ChartsDir: helm.ChartsDir,
+	}
+
+	cmd := cobra.Command{
+		Use:   cmdName,
+		Short: "Show the differences between the resources of a DaprInstance and the live cluster",
+		Long: `Show the differences between the resources of a DaprInstance and the live cluster.
+
+The command exits with 0 if there are no differences, 1 if there are differences and
+greater than 1 if the differences cannot be computed.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != OutputUnified && output != OutputJSONPatch {
+				return failure(fmt.Errorf("%w: %s", ErrUnsupportedOutput, output))
+			}
+
+			c, ns, err := newClient(kubeconfig, kubecontext, controller.Scheme)
+			if err != nil {
+				return failure(err)
+			}
+
+			if namespace == "" {
+				namespace = ns
+			}
+
+			res, err := render.LoadDaprInstance(file, namespace)
+			if err != nil {
+				return failure(err)
+			}
+
+			if err := merge(cmd.Context(), c, res); err != nil {
+				return failure(err)
+			}
+
+			changes, err := instance.NewRenderer(c, helmOpts).Changes(cmd.Context(), res)
+			if err != nil {
+				return failure(fmt.Errorf("unable to compute changes of DaprInstance %s/%s: %w", res.Namespace, res.Name, err))
+			}
+
+			switch output {
+			case OutputJSONPatch:
+				err = printJSONPatch(cmd.OutOrStdout(), changes)
+			default:
+				err = printUnified(cmd.OutOrStdout(), changes)
+			}
+
+			if err != nil {
+				return failure(err)
+			}
+
+			if len(changes) > 0 {
+				cmd.SilenceErrors = true
+
+				return &ExitError{Code: ExitCodeChanges, Err: ErrChangesDetected}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&file, "file", "f", file, "The file containing the DaprInstance, - to read from stdin.")
+	cmd.Flags().StringVarP(
+		&namespace, "namespace", "n", namespace, "The namespace of the DaprInstance, if not set in the manifest.")
+	cmd.Flags().StringVar(
+		&kubeconfig, "kubeconfig", kubeconfig, "Path to the kubeconfig file.")
+	cmd.Flags().StringVar(
+		&kubecontext, "context", kubecontext, "The kubeconfig context to use.")
+	cmd.Flags().StringVarP(
+		&output, "output", "o", output, "The output format, one of: unified, json-patch.")
+	cmd.Flags().StringVar(
+		&helmOpts.ChartsDir, "helm-charts-dir", helmOpts.ChartsDir, "Helm charts dir.")
+
+	return &cmd
+}
+
+//nolint:gochecknoinits
+func init() {
+	utilruntime.Must(daprApi.AddToScheme(controller.Scheme))
+}
+
+// merge copies the identity, generation and status of the live DaprInstance, if any, so
+// that the owner references and the objects to be pruned match what the controller would
+// compute once the given DaprInstance is applied.
+func merge(ctx context.Context, c *client.Client, res *daprApi.DaprInstance) error {
+	live := daprApi.DaprInstance{}
+
+	err := c.Get(ctx, ctrlCli.ObjectKeyFromObject(res), &live)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to get DaprInstance %s/%s: %w", res.Namespace, res.Name, err)
+	}
+
+	res.UID = live.UID
+	res.Generation = live.Generation
+	res.Status = live.Status
+
+	if !equality.Semantic.DeepEqual(res.Spec, live.Spec) {
+		res.Generation++
+	}
+
+	return nil
+}
+
+func printUnified(out io.Writer, changes []instance.Change) error {
+	for i := range changes {
+		from, err := toYAML(changes[i].Live)
+		if err != nil {
+			return err
+		}
+
+		to, err := toYAML(changes[i].Desired)
+		if err != nil {
+			return err
+		}
+
+		ref := ref(changes[i])
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(from),
+			B:        difflib.SplitLines(to),
+			FromFile: "live/" + ref,
+			ToFile:   "desired/" + ref,
+			Context:  3,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to compute diff of %s: %w", ref, err)
+		}
+
+		if _, err := fmt.Fprintf(out, "# %s %s\n%s", changes[i].Action, ref, diff); err != nil {
+			return fmt.Errorf("unable to write diff: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type patch struct {
+	Action     string         `json:"action"`
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Namespace  string         `json:"namespace,omitempty"`
+	Name       string         `json:"name"`
+	Patch      jsondiff.Patch `json:"patch,omitempty"`
+}
+
+func printJSONPatch(out io.Writer, changes []instance.Change) error {
+	patches := make([]patch, 0, len(changes))
+
+	for i := range changes {
+		obj := changes[i].Desired
+		if obj == nil {
+			obj = changes[i].Live
+		}
+
+		p := patch{
+			Action:     changes[i].Action,
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		}
+
+		if changes[i].Action != instance.ChangePrune {
+			var from interface{}
+			if changes[i].Live != nil {
+				from = mask(changes[i].Live).Object
+			}
+
+			d, err := jsondiff.Compare(from, mask(changes[i].Desired).Object)
+			if err != nil {
+				return fmt.Errorf("unable to compute patch of %s: %w", ref(changes[i]), err)
+			}
+
+			p.Patch = d
+		}
+
+		patches = append(patches, p)
+	}
+
+	data, err := json.MarshalIndent(patches, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal patches: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(out, string(data)); err != nil {
+		return fmt.Errorf("unable to write patches: %w", err)
+	}
+
+	return nil
+}
+
+func toYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(mask(obj).Object)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal %s: %w", resources.Ref(obj), err)
+	}
+
+	return string(data), nil
+}
+
+func ref(c instance.Change) string {
+	obj := c.Desired
+	if obj == nil {
+		obj = c.Live
+	}
+
+	if obj.GetNamespace() == "" {
+		return strings.ToLower(obj.GetKind()) + "/" + obj.GetName()
+	}
+
+	return strings.ToLower(obj.GetKind()) + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// mask replaces the content of Secrets with its digest, so that changes are detected
+// without the content being disclosed.
+func mask(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if obj.GetAPIVersion() != "v1" || obj.GetKind() != "Secret" {
+		return obj
+	}
+
+	answer := obj.DeepCopy()
+
+	for _, field := range []string{"data", "stringData"} {
+		m, ok, _ := unstructured.NestedMap(answer.Object, field)
+		if !ok {
+			continue
+		}
+
+		for k, v := range m {
+			m[k] = fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(fmt.Sprint(v))))
+		}
+
+		_ = unstructured.SetNestedMap(answer.Object, m, field)
+	}
+
+	return answer
+}
@@ -0,0 +1,238 @@
+package diff_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	ctrlFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/cmd/diff"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	chartsDir = "../../" + helm.ChartsDir
+
+	daprInstanceUID = types.UID("5d0c1ad4-8c5e-4bb4-a2b3-6f1b9f0e6f35")
+
+	daprInstanceManifest = `
+apiVersion: operator.dapr.io/v1beta1
+kind: DaprInstance
+metadata:
+  name: dapr-instance
+  namespace: dapr-system
+spec:
+  values:
+    dapr_operator:
+      logLevel: debug
+`
+)
+
+// restMapper maps the resources rendered by the chart, in place of the discovery.
+func restMapper() meta.RESTMapper {
+	m := meta.NewDefaultRESTMapper(nil)
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+		{Group: "", Version: "v1", Kind: "Secret"},
+		{Group: "", Version: "v1", Kind: "Service"},
+		{Group: "", Version: "v1", Kind: "ConfigMap"},
+		{Group: "", Version: "v1", Kind: "ServiceAccount"},
+		{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+		{Group: "dapr.io", Version: "v1alpha1", Kind: "Configuration"},
+	} {
+		m.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"},
+	} {
+		m.Add(gvk, meta.RESTScopeRoot)
+	}
+
+	return m
+}
+
+// newClient returns a client backed by fake clients, the DaprInstance is served by the
+// controller-runtime client while the rendered objects are served by the dynamic one.
+func newClient(res *daprApi.DaprInstance, live ...runtime.Object) *client.Client {
+	cc := ctrlFake.NewClientBuilder().WithScheme(controller.Scheme)
+	if res != nil {
+		cc = cc.WithObjects(res)
+	}
+
+	dc := dynamicFake.NewSimpleDynamicClient(controller.Scheme, live...)
+
+	// the fake client can neither apply unstructured objects nor dry-run, the applies are
+	// answered with the applied object without storing it
+	dc.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		//nolint:forcetypeassert
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+
+		obj := unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+
+		return true, &obj, nil
+	})
+
+	kc := kubeFake.NewClientset()
+
+	// the service account is not allowed to list any object to prune
+	kc.PrependReactor("create", "selfsubjectrulesreviews", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectRulesReview{}, nil
+	})
+
+	return client.NewClientFor(controller.Scheme, cc.Build(), kc, dc, restMapper())
+}
+
+func daprInstance(uid types.UID) *daprApi.DaprInstance {
+	return &daprApi.DaprInstance{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: daprApi.GroupVersion.String(),
+			Kind:       "DaprInstance",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       instance.DaprInstanceResourceName,
+			Namespace:  "dapr-system",
+			UID:        uid,
+			Generation: 1,
+		},
+		Spec: daprApi.DaprInstanceSpec{
+			Values: &daprApi.JSON{RawMessage: daprApi.RawMessage(`{"dapr_operator":{"logLevel":"debug"}}`)},
+		},
+	}
+}
+
+func run(t *testing.T, c *client.Client, args ...string) (string, error) {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "dapr-instance.yaml")
+
+	if err := os.WriteFile(file, []byte(daprInstanceManifest), 0o600); err != nil {
+		t.Fatalf("cannot write manifest: %v", err)
+	}
+
+	out := bytes.Buffer{}
+
+	cmd := diff.NewCmdForClient(c, "default")
+	cmd.SetArgs(append([]string{"--file", file, "--helm-charts-dir", chartsDir}, args...))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.ExecuteContext(t.Context())
+
+	return out.String(), err
+}
+
+func exitCode(err error) int {
+	var ee *diff.ExitError
+
+	if !errors.As(err, &ee) {
+		return 0
+	}
+
+	return ee.ExitCode()
+}
+
+func TestDiffCmdUnified(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := run(t, newClient(nil))
+	g.Expect(err).To(MatchError(diff.ErrChangesDetected))
+	g.Expect(exitCode(err)).To(Equal(diff.ExitCodeChanges))
+
+	g.Expect(out).To(ContainSubstring("# create deployment/dapr-system/dapr-operator\n"))
+	g.Expect(out).To(ContainSubstring("+++ desired/deployment/dapr-system/dapr-operator"))
+	g.Expect(out).To(ContainSubstring("# create clusterrole/dapr-operator-admin\n"))
+
+	// the DaprInstance does not exist yet, so the objects cannot be owned by it
+	g.Expect(out).ToNot(ContainSubstring("ownerReferences"))
+}
+
+func TestDiffCmdOwnerReferences(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := run(t, newClient(daprInstance(daprInstanceUID)))
+	g.Expect(exitCode(err)).To(Equal(diff.ExitCodeChanges))
+
+	g.Expect(out).To(ContainSubstring("ownerReferences"))
+	g.Expect(out).To(ContainSubstring(string(daprInstanceUID)))
+}
+
+func TestDiffCmdJSONPatch(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := run(t, newClient(nil), "--output", diff.OutputJSONPatch)
+	g.Expect(exitCode(err)).To(Equal(diff.ExitCodeChanges))
+
+	patches := make([]map[string]interface{}, 0)
+	g.Expect(json.Unmarshal([]byte(out), &patches)).To(Succeed())
+	g.Expect(patches).To(ContainElement(And(
+		HaveKeyWithValue("action", instance.ChangeCreate),
+		HaveKeyWithValue("kind", "Deployment"),
+		HaveKeyWithValue("namespace", "dapr-system"),
+		HaveKeyWithValue("name", "dapr-operator"),
+		HaveKey("patch"),
+	)))
+}
+
+func TestDiffCmdNoChanges(t *testing.T) {
+	g := NewWithT(t)
+
+	res := daprInstance(daprInstanceUID)
+
+	// the live objects are the ones the controller applied for the DaprInstance
+	items, err := instance.NewRenderer(newClient(res), helm.Options{ChartsDir: chartsDir}).Render(t.Context(), res)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	live := make([]runtime.Object, 0, len(items))
+	for i := range items {
+		live = append(live, &items[i])
+	}
+
+	out, err := run(t, newClient(res, live...))
+	g.Expect(err).ToNot(HaveOccurred(), out)
+	g.Expect(out).To(BeEmpty(), out)
+}
+
+func TestDiffCmdFailures(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := run(t, newClient(nil), "--output", "table")
+	g.Expect(err).To(MatchError(diff.ErrUnsupportedOutput))
+	g.Expect(exitCode(err)).To(Equal(diff.ExitCodeFailure))
+
+	_, err = run(t, newClient(nil), "--file", filepath.Join(t.TempDir(), "missing.yaml"))
+	g.Expect(err).To(MatchError(os.ErrNotExist))
+	g.Expect(exitCode(err)).To(Equal(diff.ExitCodeFailure))
+}
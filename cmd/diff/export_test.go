@@ -0,0 +1,16 @@
+package diff //nolint:testpackage
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+)
+
+// NewCmdForClient returns the diff command running against the given client, in place of the
+// one created out of the kubeconfig.
+func NewCmdForClient(c *client.Client, namespace string) *cobra.Command {
+	return newCmd(func(string, string, *runtime.Scheme) (*client.Client, string, error) {
+		return c, namespace, nil
+	})
+}
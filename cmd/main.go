@@ -17,10 +17,14 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"flag"
 	"os"
 
+	"github.com/dapr/kubernetes-operator/cmd/diff"
+	"github.com/dapr/kubernetes-operator/cmd/migrate"
 	"github.com/dapr/kubernetes-operator/cmd/modelschema"
+	"github.com/dapr/kubernetes-operator/cmd/render"
 
 	"github.com/dapr/kubernetes-operator/cmd/run"
 	"github.com/dapr/kubernetes-operator/pkg/logger"
@@ -30,6 +34,12 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// exitCoder is implemented by the errors that carry the exit code the command must
+// terminate with.
+type exitCoder interface {
+	ExitCode() int
+}
+
 func main() {
 	var rootCmd = &cobra.Command{
 		Use:   "dapr-control-plane",
@@ -40,6 +50,9 @@ func main() {
 
 	rootCmd.AddCommand(modelschema.NewCmd())
 	rootCmd.AddCommand(run.NewCmd())
+	rootCmd.AddCommand(render.NewCmd())
+	rootCmd.AddCommand(diff.NewCmd())
+	rootCmd.AddCommand(migrate.NewCmd())
 
 	fs := flag.NewFlagSet("", flag.PanicOnError)
 
@@ -49,7 +62,22 @@ func main() {
 	rootCmd.PersistentFlags().AddGoFlagSet(fs)
 
 	if err := rootCmd.Execute(); err != nil {
-		klog.ErrorS(err, "problem running command")
-		os.Exit(1)
+		// the changes detected by the diff are reported by its output
+		if !errors.Is(err, diff.ErrChangesDetected) {
+			klog.ErrorS(err, "problem running command")
+		}
+
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode returns the exit code carried by the given error, 1 if it carries none.
+func exitCode(err error) int {
+	var ec exitCoder
+
+	if errors.As(err, &ec) {
+		return ec.ExitCode()
 	}
+
+	return 1
 }
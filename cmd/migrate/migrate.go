@@ -0,0 +1,366 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/yaml"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	cmdName = "migrate"
+
+	// DefaultChartRepo is the repository the Dapr chart is published to.
+	DefaultChartRepo = "https://dapr.github.io/helm-charts"
+
+	// AdoptedGeneration is the release generation set on the adopted objects, so that the
+	// objects that are not part of the first release rendered by the operator are garbage
+	// collected.
+	AdoptedGeneration = "0"
+)
+
+var (
+	ErrDaprInstanceExists   = errors.New("DaprInstance already exists")
+	ErrUnsupportedNamespace = errors.New("unsupported namespace")
+)
+
+type options struct {
+	kubeconfig        string
+	context           string
+	release           string
+	namespace         string
+	operatorNamespace string
+	chartRepo         string
+	deleteRelease     bool
+	dryRun            bool
+}
+
+// clientFactory creates a client for the given kubeconfig file and context, returning the
+// namespace of the context too.
+type clientFactory func(kubeconfig string, context string, scheme *runtime.Scheme) (*client.Client, string, error)
+
+func NewCmd() *cobra.Command {
+	return newCmd(client.NewClientForKubeconfig)
+}
+
+func newCmd(newClient clientFactory) *cobra.Command {
+	o := options{
+		release:           "dapr",
+		operatorNamespace: controller.OperatorNamespace(),
+		chartRepo:         DefaultChartRepo,
+	}
+
+	cmd := cobra.Command{
+		Use:   cmdName,
+		Short: "Adopt a Dapr control plane installed with Helm",
+		Long: `Adopt a Dapr control plane installed with Helm.
+
+The values of the deployed Helm release are converted into a DaprInstance pinned to the
+same chart version, the live objects are labelled as part of the DaprInstance release so
+that the operator takes them over, and the Helm release records are optionally deleted.
+
+As the operator only reconciles the dapr-instance DaprInstance of its own namespace, the
+release must live in the namespace the operator runs in.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ns, err := newClient(o.kubeconfig, o.context, controller.Scheme)
+			if err != nil {
+				return err
+			}
+
+			if o.namespace == "" {
+				o.namespace = ns
+			}
+
+			return run(cmd.Context(), cmd.OutOrStdout(), c, o)
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&o.kubeconfig, "kubeconfig", o.kubeconfig, "Path to the kubeconfig file.")
+	cmd.Flags().StringVar(
+		&o.context, "context", o.context, "The kubeconfig context to use.")
+	cmd.Flags().StringVar(
+		&o.release, "release", o.release, "The name of the Helm release to adopt.")
+	cmd.Flags().StringVarP(
+		&o.namespace, "namespace", "n", o.namespace, "The namespace of the Helm release, where the DaprInstance is created.")
+	cmd.Flags().StringVar(
+		&o.operatorNamespace, "operator-namespace", o.operatorNamespace, "The namespace the operator runs in.")
+	cmd.Flags().StringVar(
+		&o.chartRepo, "chart-repo", o.chartRepo, "The repository the chart of the Helm release is published to.")
+	cmd.Flags().BoolVar(
+		&o.deleteRelease, "delete-release", o.deleteRelease, "Delete the Helm release records once the release has been adopted.")
+	cmd.Flags().BoolVar(
+		&o.dryRun, "dry-run", o.dryRun, "Print the DaprInstance without changing the cluster.")
+
+	return &cmd
+}
+
+//nolint:gochecknoinits
+func init() {
+	utilruntime.Must(daprApi.AddToScheme(controller.Scheme))
+}
+
+func run(ctx context.Context, out io.Writer, c *client.Client, o options) error {
+	// a DaprInstance in any other namespace would never be reconciled
+	if o.namespace != o.operatorNamespace {
+		return fmt.Errorf("%w: %s, the Helm release must be in the namespace the operator runs in: %s",
+			ErrUnsupportedNamespace, o.namespace, o.operatorNamespace)
+	}
+
+	s := helm.ReleaseStorage(c.CoreV1().Secrets(o.namespace))
+
+	rel, err := s.Deployed(o.release)
+	if err != nil {
+		return fmt.Errorf("unable to find deployed Helm release %s/%s: %w", o.namespace, o.release, err)
+	}
+
+	res, err := newDaprInstance(rel, o)
+	if err != nil {
+		return err
+	}
+
+	if o.dryRun {
+		data, err := yaml.Marshal(res)
+		if err != nil {
+			return fmt.Errorf("unable to marshal DaprInstance: %w", err)
+		}
+
+		_, err = fmt.Fprintf(out, "%s", data)
+		if err != nil {
+			return fmt.Errorf("unable to write DaprInstance: %w", err)
+		}
+
+		return nil
+	}
+
+	existing := daprApi.DaprInstance{}
+
+	err = c.Get(ctx, types.NamespacedName{Namespace: res.Namespace, Name: res.Name}, &existing)
+	switch {
+	case err == nil:
+		return fmt.Errorf("%w: %s/%s", ErrDaprInstanceExists, res.Namespace, res.Name)
+	case !k8serrors.IsNotFound(err):
+		return fmt.Errorf("unable to get DaprInstance %s/%s: %w", res.Namespace, res.Name, err)
+	}
+
+	// the objects are labelled before the DaprInstance is created, so the first release
+	// rendered by the operator supersedes the adopted one, the labels are removed if the
+	// DaprInstance cannot be created so the objects are not left adopted by nobody
+	adopted, err := adopt(ctx, out, c, rel, res)
+	if err != nil {
+		return errors.Join(err, disown(ctx, out, c, rel, adopted))
+	}
+
+	if err := c.Create(ctx, res); err != nil {
+		return errors.Join(
+			fmt.Errorf("unable to create DaprInstance %s/%s: %w", res.Namespace, res.Name, err),
+			disown(ctx, out, c, rel, adopted))
+	}
+
+	_, _ = fmt.Fprintf(out, "daprinstance/%s/%s created\n", res.Namespace, res.Name)
+
+	if !o.deleteRelease {
+		return nil
+	}
+
+	history, err := s.History(rel.Name)
+	if err != nil {
+		return fmt.Errorf("unable to list Helm release %s/%s history: %w", rel.Namespace, rel.Name, err)
+	}
+
+	for _, r := range history {
+		if _, err := s.Delete(r.Name, r.Version); err != nil {
+			return fmt.Errorf("unable to delete Helm release %s/%s version %d: %w", r.Namespace, r.Name, r.Version, err)
+		}
+
+		_, _ = fmt.Fprintf(out, "helm release %s/%s version %d deleted\n", r.Namespace, r.Name, r.Version)
+	}
+
+	return nil
+}
+
+// newDaprInstance converts the given Helm release into a DaprInstance, the chart version is
+// pinned to the one of the release so no upgrade is performed while adopting it.
+func newDaprInstance(rel *release.Release, o options) (*daprApi.DaprInstance, error) {
+	values := rel.Config
+	if values == nil {
+		values = make(map[string]interface{})
+	}
+
+	// the chart labels the pods with the release name, retain the original value so
+	// that the workloads are not rolled out because of the new release name
+	if rel.Name != instance.DaprInstanceResourceName {
+		if err := setDefault(values, rel.Name, "global", "k8sLabels", "app.kubernetes.io/name"); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal Helm release values: %w", err)
+	}
+
+	res := daprApi.DaprInstance{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: daprApi.GroupVersion.String(),
+			Kind:       "DaprInstance",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.DaprInstanceResourceName,
+			Namespace: o.namespace,
+		},
+		Spec: daprApi.DaprInstanceSpec{
+			Chart: &daprApi.ChartSpec{
+				Repo:    o.chartRepo,
+				Name:    rel.Chart.Metadata.Name,
+				Version: rel.Chart.Metadata.Version,
+			},
+			Values: &daprApi.JSON{
+				RawMessage: data,
+			},
+		},
+	}
+
+	return &res, nil
+}
+
+// adopt labels the live objects of the given release as part of the release of the given
+// DaprInstance, the objects that have been labelled are returned, also in case of error.
+func adopt(ctx context.Context, out io.Writer, c *client.Client, rel *release.Release, res *daprApi.DaprInstance) ([]unstructured.Unstructured, error) {
+	manifests := releaseutil.SplitManifests(rel.Manifest)
+
+	keys := make([]string, 0, len(manifests))
+	for k := range manifests {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	labels := map[string]string{
+		helm.ReleaseGeneration: AdoptedGeneration,
+		helm.ReleaseName:       res.Name,
+		helm.ReleaseNamespace:  res.Namespace,
+		helm.ReleaseVersion:    res.Spec.Chart.Version,
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": labels,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal patch: %w", err)
+	}
+
+	adopted := make([]unstructured.Unstructured, 0, len(keys))
+
+	for _, k := range keys {
+		obj := unstructured.Unstructured{}
+
+		if err := yaml.Unmarshal([]byte(manifests[k]), &obj.Object); err != nil {
+			return adopted, fmt.Errorf("unable to decode Helm release manifest: %w", err)
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		dc, err := c.Dynamic(rel.Namespace, &obj)
+		if err != nil {
+			return adopted, fmt.Errorf("cannot create dynamic client: %w", err)
+		}
+
+		_, err = dc.Patch(ctx, obj.GetName(), types.MergePatchType, patch, metav1.PatchOptions{
+			FieldManager: controller.FieldManager,
+		})
+
+		switch {
+		case k8serrors.IsNotFound(err):
+			_, _ = fmt.Fprintf(out, "%s not found, skipped\n", resources.Ref(&obj))
+		case err != nil:
+			return adopted, fmt.Errorf("cannot label object %s: %w", resources.Ref(&obj), err)
+		default:
+			_, _ = fmt.Fprintf(out, "%s adopted\n", resources.Ref(&obj))
+
+			adopted = append(adopted, obj)
+		}
+	}
+
+	return adopted, nil
+}
+
+// disown removes the labels set by adopt from the given objects.
+func disown(ctx context.Context, out io.Writer, c *client.Client, rel *release.Release, objs []unstructured.Unstructured) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				helm.ReleaseGeneration: nil,
+				helm.ReleaseName:       nil,
+				helm.ReleaseNamespace:  nil,
+				helm.ReleaseVersion:    nil,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal patch: %w", err)
+	}
+
+	errs := make([]error, 0)
+
+	for i := range objs {
+		dc, err := c.Dynamic(rel.Namespace, &objs[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cannot create dynamic client: %w", err))
+
+			continue
+		}
+
+		_, err = dc.Patch(ctx, objs[i].GetName(), types.MergePatchType, patch, metav1.PatchOptions{
+			FieldManager: controller.FieldManager,
+		})
+
+		switch {
+		case k8serrors.IsNotFound(err):
+			continue
+		case err != nil:
+			errs = append(errs, fmt.Errorf("cannot remove labels from object %s: %w", resources.Ref(&objs[i]), err))
+		default:
+			_, _ = fmt.Fprintf(out, "%s released\n", resources.Ref(&objs[i]))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func setDefault(values map[string]interface{}, value string, fields ...string) error {
+	if _, ok, _ := unstructured.NestedFieldNoCopy(values, fields...); ok {
+		return nil
+	}
+
+	if err := unstructured.SetNestedField(values, value, fields...); err != nil {
+		return fmt.Errorf("unable to set %v: %w", fields, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,316 @@
+package migrate_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/cmd/migrate"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	namespace = "dapr-system"
+
+	releaseManifest = `---
+# Source: dapr/charts/dapr_operator/templates/dapr_operator_service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: dapr-api
+  namespace: dapr-system
+---
+# Source: dapr/charts/dapr_sentry/templates/dapr_sentry_service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: dapr-sentry
+  namespace: dapr-system
+---
+# Source: dapr/charts/dapr_config/templates/dapr_default_config.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: dapr-removed
+  namespace: dapr-system
+`
+)
+
+var errCreate = errors.New("create failed")
+
+func helmRelease(version int, status release.Status) *release.Release {
+	return &release.Release{
+		Name:      "dapr",
+		Namespace: namespace,
+		Version:   version,
+		Info:      &release.Info{Status: status},
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{Name: "dapr", Version: "1.16.1"},
+		},
+		Config: map[string]interface{}{
+			"global": map[string]interface{}{"logAsJson": true},
+		},
+		Manifest: releaseManifest,
+	}
+}
+
+func service(name string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
+
+type cluster struct {
+	client  *client.Client
+	kube    *kubeFake.Clientset
+	dynamic *dynamicFake.FakeDynamicClient
+}
+
+// newCluster returns a cluster holding the given Helm releases and live services, the
+// creation of the DaprInstance fails if createErr is set.
+func newCluster(t *testing.T, createErr error, objects []ctrlCli.Object, releases ...*release.Release) *cluster {
+	t.Helper()
+
+	kc := kubeFake.NewClientset()
+	kc.PrependReactor("create", "selfsubjectrulesreviews", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectRulesReview{}, nil
+	})
+
+	s := helm.ReleaseStorage(kc.CoreV1().Secrets(namespace))
+	for _, r := range releases {
+		if err := s.Create(r); err != nil {
+			t.Fatalf("cannot store release: %v", err)
+		}
+	}
+
+	cc := ctrlFake.NewClientBuilder().
+		WithScheme(controller.Scheme).
+		WithObjects(objects...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c ctrlCli.WithWatch, obj ctrlCli.Object, opts ...ctrlCli.CreateOption) error {
+				if createErr != nil {
+					return createErr
+				}
+
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	m := meta.NewDefaultRESTMapper(nil)
+	m.Add(schema.GroupVersionKind{Version: "v1", Kind: "Service"}, meta.RESTScopeNamespace)
+	m.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+	dc := dynamicFake.NewSimpleDynamicClient(controller.Scheme, service("dapr-api"), service("dapr-sentry"))
+
+	return &cluster{
+		client:  client.NewClientFor(controller.Scheme, cc, kc, dc, m),
+		kube:    kc,
+		dynamic: dc,
+	}
+}
+
+func (c *cluster) run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	out := bytes.Buffer{}
+
+	cmd := migrate.NewCmdForClient(c.client, namespace)
+	cmd.SetArgs(append([]string{"--operator-namespace", namespace}, args...))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.ExecuteContext(t.Context())
+
+	return out.String(), err
+}
+
+func (c *cluster) labels(t *testing.T, name string) map[string]string {
+	t.Helper()
+
+	u, err := c.dynamic.Resource(corev1.SchemeGroupVersion.WithResource("services")).Namespace(namespace).Get(t.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("cannot get service %s: %v", name, err)
+	}
+
+	return u.GetLabels()
+}
+
+func (c *cluster) daprInstance(t *testing.T) (*daprApi.DaprInstance, error) {
+	t.Helper()
+
+	res := daprApi.DaprInstance{}
+
+	err := c.client.Get(t.Context(), ctrlCli.ObjectKey{Namespace: namespace, Name: instance.DaprInstanceResourceName}, &res)
+
+	return &res, err
+}
+
+func TestMigrate(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newCluster(t, nil, nil,
+		helmRelease(1, release.StatusSuperseded),
+		helmRelease(2, release.StatusDeployed))
+
+	out, err := c.run(t)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("Service:v1:dapr-api adopted"))
+	g.Expect(out).To(ContainSubstring("ConfigMap:v1:dapr-removed not found, skipped"))
+
+	for _, name := range []string{"dapr-api", "dapr-sentry"} {
+		g.Expect(c.labels(t, name)).To(Equal(map[string]string{
+			helm.ReleaseGeneration: migrate.AdoptedGeneration,
+			helm.ReleaseName:       instance.DaprInstanceResourceName,
+			helm.ReleaseNamespace:  namespace,
+			helm.ReleaseVersion:    "1.16.1",
+		}))
+	}
+
+	res, err := c.daprInstance(t)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Spec.Chart).To(Equal(&daprApi.ChartSpec{
+		Repo:    migrate.DefaultChartRepo,
+		Name:    "dapr",
+		Version: "1.16.1",
+	}))
+
+	values := map[string]interface{}{}
+	g.Expect(json.Unmarshal(res.Spec.Values.RawMessage, &values)).To(Succeed())
+
+	// the pods keep the label of the original release name
+	g.Expect(values).To(Equal(map[string]interface{}{
+		"global": map[string]interface{}{
+			"logAsJson": true,
+			"k8sLabels": map[string]interface{}{"app.kubernetes.io/name": "dapr"},
+		},
+	}))
+
+	// the release records are retained
+	history, err := helm.ReleaseStorage(c.kube.CoreV1().Secrets(namespace)).History("dapr")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(history).To(HaveLen(2))
+}
+
+func TestMigrateDeleteRelease(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newCluster(t, nil, nil,
+		helmRelease(1, release.StatusSuperseded),
+		helmRelease(2, release.StatusDeployed))
+
+	out, err := c.run(t, "--delete-release")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("helm release dapr-system/dapr version 1 deleted"))
+	g.Expect(out).To(ContainSubstring("helm release dapr-system/dapr version 2 deleted"))
+
+	secrets, err := c.kube.CoreV1().Secrets(namespace).List(t.Context(), metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(secrets.Items).To(BeEmpty())
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newCluster(t, nil, nil, helmRelease(1, release.StatusDeployed))
+
+	out, err := c.run(t, "--dry-run")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out).To(ContainSubstring("kind: DaprInstance"))
+	g.Expect(out).To(ContainSubstring("name: " + instance.DaprInstanceResourceName))
+
+	g.Expect(c.labels(t, "dapr-api")).To(BeEmpty())
+
+	_, err = c.daprInstance(t)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestMigrateRollback(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newCluster(t, errCreate, nil, helmRelease(1, release.StatusDeployed))
+
+	out, err := c.run(t)
+	g.Expect(err).To(MatchError(errCreate))
+	g.Expect(out).To(ContainSubstring("Service:v1:dapr-api released"))
+
+	// the objects are not left adopted by a DaprInstance that does not exist
+	for _, name := range []string{"dapr-api", "dapr-sentry"} {
+		g.Expect(c.labels(t, name)).To(BeEmpty())
+	}
+}
+
+func TestMigrateErrors(t *testing.T) {
+	existing := &daprApi.DaprInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.DaprInstanceResourceName, Namespace: namespace},
+	}
+
+	tests := []struct {
+		name     string
+		objects  []ctrlCli.Object
+		releases []*release.Release
+		args     []string
+		err      error
+	}{
+		{
+			name:     "DaprInstance exists",
+			objects:  []ctrlCli.Object{existing},
+			releases: []*release.Release{helmRelease(1, release.StatusDeployed)},
+			err:      migrate.ErrDaprInstanceExists,
+		},
+		{
+			name:     "namespace not watched by the operator",
+			releases: []*release.Release{helmRelease(1, release.StatusDeployed)},
+			args:     []string{"--namespace", "dapr-other"},
+			err:      migrate.ErrUnsupportedNamespace,
+		},
+		{
+			name:     "no deployed release",
+			releases: []*release.Release{helmRelease(1, release.StatusFailed)},
+		},
+		{
+			name: "no release",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := newCluster(t, nil, tt.objects, tt.releases...)
+
+			_, err := c.run(t, tt.args...)
+			g.Expect(err).To(HaveOccurred())
+
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+			}
+
+			g.Expect(c.labels(t, "dapr-api")).To(BeEmpty())
+		})
+	}
+}
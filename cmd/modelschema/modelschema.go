@@ -2,75 +2,222 @@ package modelschema
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/dapr/kubernetes-operator/pkg/generated/openapi"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
 	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/chart/loader"
 	"k8s.io/kube-openapi/pkg/common"
 	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 )
 
 const (
 	cmdName = "modelschema"
+
+	OutputOpenAPI    = "openapi"
+	OutputJSONSchema = "jsonschema"
+
+	daprInstanceDefinition     = "github.com/dapr/kubernetes-operator/api/operator/v1beta1.DaprInstance"
+	daprInstanceSpecDefinition = "github.com/dapr/kubernetes-operator/api/operator/v1beta1.DaprInstanceSpec"
 )
 
+var ErrUnsupportedOutput = errors.New("unsupported output")
+
 func NewCmd() *cobra.Command {
-	// Outputs openAPI schema JSON containing the schema definitions in zz_generated.openapi.go.
+	output := OutputOpenAPI
+	chartsDir := helm.ChartsDir
+
 	cmd := cobra.Command{
 		Use:   cmdName,
-		Short: cmdName,
+		Short: "Print the schema of the API types",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			refFunc := func(name string) spec.Ref {
-				return spec.MustCreateRef("#/definitions/" + friendlyName(name))
+			var data []byte
+			var err error
+
+			switch output {
+			case OutputOpenAPI:
+				data, err = openAPISchema()
+			case OutputJSONSchema:
+				data, err = daprInstanceJSONSchema(chartsDir)
+			default:
+				err = fmt.Errorf("%w: %s", ErrUnsupportedOutput, output)
 			}
 
-			defs := openapi.GetOpenAPIDefinitions(refFunc)
-			schemaDefs := make(map[string]spec.Schema, len(defs))
-
-			for k, v := range defs {
-				// Replace top-level schema with v2 if a v2 schema is embedded
-				// so that the output of this program is always in OpenAPI v2.
-				// This is done by looking up an extension that marks the embedded v2
-				// schema, and, if the v2 schema is found, make it the resulting schema for
-				// the type.
-				if schema, ok := v.Schema.Extensions[common.ExtensionV2Schema]; ok {
-					if v2Schema, isOpenAPISchema := schema.(spec.Schema); isOpenAPISchema {
-						schemaDefs[friendlyName(k)] = v2Schema
-						continue
-					}
-				}
-
-				schemaDefs[friendlyName(k)] = v.Schema
-			}
-
-			data, err := json.Marshal(&spec.Swagger{
-				SwaggerProps: spec.SwaggerProps{
-					Definitions: schemaDefs,
-					Info: &spec.Info{
-						InfoProps: spec.InfoProps{
-							Title:   "Gateway API",
-							Version: "unversioned",
-						},
-					},
-					Swagger: "2.0",
-				},
-			})
-
 			if err != nil {
-				return fmt.Errorf("error serializing api definitions: %w", err)
+				return err
 			}
 
-			os.Stdout.Write(data)
+			if _, err := cmd.OutOrStdout().Write(data); err != nil {
+				return fmt.Errorf("unable to write schema: %w", err)
+			}
 
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVarP(
+		&output, "output", "o", output,
+		"The output format, either openapi for the OpenAPI v2 definitions of all the API types, or jsonschema for the JSON Schema of DaprInstance including the chart values.")
+	cmd.Flags().StringVar(
+		&chartsDir, "helm-charts-dir", chartsDir, "Helm charts dir, the values of the chart are included in the jsonschema output.")
+
 	return &cmd
 }
 
+// openAPISchema outputs openAPI schema JSON containing the schema definitions in zz_generated.openapi.go.
+func openAPISchema() ([]byte, error) {
+	data, err := json.Marshal(&spec.Swagger{
+		SwaggerProps: spec.SwaggerProps{
+			Definitions: definitions(),
+			Info: &spec.Info{
+				InfoProps: spec.InfoProps{
+					Title:   "Dapr Kubernetes Operator API",
+					Version: "unversioned",
+				},
+			},
+			Swagger: "2.0",
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error serializing api definitions: %w", err)
+	}
+
+	return data, nil
+}
+
+// daprInstanceJSONSchema outputs a standalone JSON Schema of DaprInstance, including only the
+// definitions it depends on. The opaque spec.values is replaced by the schema of the values
+// of the chart found in the given directory, so manifests can be validated offline.
+func daprInstanceJSONSchema(chartsDir string) ([]byte, error) {
+	c, err := loader.Load(chartsDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load chart from %s: %w", chartsDir, err)
+	}
+
+	values, err := helm.ValuesSchema(c)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute values schema: %w", err)
+	}
+
+	refFunc := func(name string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + friendlyName(name))
+	}
+
+	defs := openapi.GetOpenAPIDefinitions(refFunc)
+	schemaDefs := make(map[string]any)
+
+	var collect func(name string) error
+
+	collect = func(name string) error {
+		if _, ok := schemaDefs[friendlyName(name)]; ok {
+			return nil
+		}
+
+		def, ok := defs[name]
+		if !ok {
+			return nil
+		}
+
+		schema := definitionSchema(def)
+
+		switch name {
+		case daprInstanceDefinition:
+			schema.Properties["apiVersion"] = *spec.StringProperty().WithEnum(daprApi.GroupVersion.String())
+			schema.Properties["kind"] = *spec.StringProperty().WithEnum("DaprInstance")
+			schema.Required = append(schema.Required, "apiVersion", "kind")
+		case daprInstanceSpecDefinition:
+			// the values are not described by the Go types, they are replaced below
+			delete(schema.Properties, "values")
+		}
+
+		data, err := json.Marshal(schema)
+		if err != nil {
+			return fmt.Errorf("error serializing definition %s: %w", name, err)
+		}
+
+		doc := make(map[string]any)
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("error serializing definition %s: %w", name, err)
+		}
+
+		if name == daprInstanceSpecDefinition {
+			properties, ok := doc["properties"].(map[string]any)
+			if !ok {
+				properties = make(map[string]any)
+				doc["properties"] = properties
+			}
+
+			properties["values"] = values
+		}
+
+		schemaDefs[friendlyName(name)] = doc
+
+		for _, dep := range def.Dependencies {
+			if name == daprInstanceSpecDefinition && strings.HasSuffix(dep, ".JSON") {
+				continue
+			}
+
+			if err := collect(dep); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := collect(daprInstanceDefinition); err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(map[string]any{
+		"$schema":     "http://json-schema.org/draft-07/schema#",
+		"title":       "DaprInstance",
+		"$ref":        "#/definitions/" + friendlyName(daprInstanceDefinition),
+		"definitions": schemaDefs,
+	}, "", "  ")
+
+	if err != nil {
+		return nil, fmt.Errorf("error serializing json schema: %w", err)
+	}
+
+	return data, nil
+}
+
+func definitions() map[string]spec.Schema {
+	refFunc := func(name string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + friendlyName(name))
+	}
+
+	defs := openapi.GetOpenAPIDefinitions(refFunc)
+	schemaDefs := make(map[string]spec.Schema, len(defs))
+
+	for k, v := range defs {
+		schemaDefs[friendlyName(k)] = definitionSchema(v)
+	}
+
+	return schemaDefs
+}
+
+// definitionSchema returns the schema of the given definition, the top-level schema is
+// replaced with v2 if a v2 schema is embedded so that the output of this program is always
+// in OpenAPI v2. This is done by looking up an extension that marks the embedded v2 schema,
+// and, if the v2 schema is found, make it the resulting schema for the type.
+func definitionSchema(def common.OpenAPIDefinition) spec.Schema {
+	if schema, ok := def.Schema.Extensions[common.ExtensionV2Schema]; ok {
+		if v2Schema, isOpenAPISchema := schema.(spec.Schema); isOpenAPISchema {
+			return v2Schema
+		}
+	}
+
+	return def.Schema
+}
+
 // From k8s.io/apiserver/pkg/endpoints/openapi/openapi.go.
 func friendlyName(name string) string {
 	nameParts := strings.Split(name, "/")
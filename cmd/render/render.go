@@ -0,0 +1,173 @@
+package render
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	daprApiV1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+)
+
+const (
+	cmdName = "render"
+)
+
+var (
+	ErrNoDaprInstance         = errors.New("no DaprInstance found")
+	ErrUnsupportedClusterType = errors.New("unsupported cluster type")
+)
+
+func NewCmd() *cobra.Command {
+	file := "-"
+	namespace := ""
+	clusterType := string(controller.ClusterTypeVanilla)
+
+	helmOpts := helm.Options{
+		ChartsDir: helm.ChartsDir,
+	}
+
+	cmd := cobra.Command{
+		Use:   cmdName,
+		Short: "Render the resources of a DaprInstance without connecting to a cluster",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := LoadDaprInstance(file, namespace)
+			if err != nil {
+				return err
+			}
+
+			if clusterType != string(controller.ClusterTypeVanilla) && clusterType != string(controller.ClusterTypeOpenShift) {
+				return fmt.Errorf("%w: %s", ErrUnsupportedClusterType, clusterType)
+			}
+
+			r := instance.NewRenderer(nil, helmOpts)
+			r.ClusterType = controller.ClusterType(clusterType)
+
+			items, err := r.Render(cmd.Context(), res)
+			if err != nil {
+				return fmt.Errorf("unable to render DaprInstance %s/%s: %w", res.Namespace, res.Name, err)
+			}
+
+			for i := range items {
+				data, err := yaml.Marshal(items[i].Object)
+				if err != nil {
+					return fmt.Errorf("unable to marshal resource: %w", err)
+				}
+
+				if _, err := fmt.Fprintf(cmd.OutOrStdout(), "---\n%s", data); err != nil {
+					return fmt.Errorf("unable to write resource: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&file, "file", "f", file, "The file containing the DaprInstance, - to read from stdin.")
+	cmd.Flags().StringVarP(
+		&namespace, "namespace", "n", namespace, "The namespace of the DaprInstance, if not set in the manifest.")
+	cmd.Flags().StringVar(
+		&helmOpts.ChartsDir, "helm-charts-dir", helmOpts.ChartsDir, "Helm charts dir.")
+	cmd.Flags().StringVar(
+		&clusterType, "cluster-type", clusterType, "The type of the target cluster, either Vanilla or OpenShift, to apply the related profile.")
+
+	return &cmd
+}
+
+// LoadDaprInstance reads the first DaprInstance found in the given file, or in stdin if the
+// file is -, defaulting the name and the namespace if they are not set.
+func LoadDaprInstance(file string, namespace string) (*daprApi.DaprInstance, error) {
+	var in io.Reader = os.Stdin
+
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open %s: %w", file, err)
+		}
+
+		defer func() { _ = f.Close() }()
+
+		in = f
+	}
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(in, 4096)
+
+	for {
+		raw := json.RawMessage{}
+
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", file, err)
+		}
+
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		tm := metav1.TypeMeta{}
+
+		if err := json.Unmarshal(raw, &tm); err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", file, err)
+		}
+
+		if tm.Kind != "DaprInstance" {
+			continue
+		}
+
+		res := daprApi.DaprInstance{}
+
+		switch tm.APIVersion {
+		case daprApi.GroupVersion.String():
+			if err := json.Unmarshal(raw, &res); err != nil {
+				return nil, fmt.Errorf("unable to decode %s: %w", file, err)
+			}
+		case daprApiV1alpha1.GroupVersion.String():
+			// older resources are converted to the version the controller works with
+			src := daprApiV1alpha1.DaprInstance{}
+
+			if err := json.Unmarshal(raw, &src); err != nil {
+				return nil, fmt.Errorf("unable to decode %s: %w", file, err)
+			}
+
+			if err := src.ConvertTo(&res); err != nil {
+				return nil, fmt.Errorf("unable to convert %s: %w", file, err)
+			}
+
+			res.APIVersion = daprApi.GroupVersion.String()
+			res.Kind = tm.Kind
+		default:
+			continue
+		}
+
+		if res.Name == "" {
+			res.Name = instance.DaprInstanceResourceName
+		}
+
+		if res.Namespace == "" {
+			res.Namespace = namespace
+		}
+
+		if res.Namespace == "" {
+			res.Namespace = "default"
+		}
+
+		return &res, nil
+	}
+
+	return nil, fmt.Errorf("%w in %s", ErrNoDaprInstance, file)
+}
@@ -0,0 +1,229 @@
+package render_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/kubernetes-operator/cmd/render"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+const chartsDir = "../../" + helm.ChartsDir
+
+func manifest(t *testing.T, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "dapr-instance.yaml")
+
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("cannot write manifest: %v", err)
+	}
+
+	return file
+}
+
+func run(t *testing.T, args ...string) ([]unstructured.Unstructured, error) {
+	t.Helper()
+
+	out := bytes.Buffer{}
+
+	cmd := render.NewCmd()
+	cmd.SetArgs(append([]string{"--helm-charts-dir", chartsDir}, args...))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+
+	if err := cmd.ExecuteContext(t.Context()); err != nil {
+		return nil, err
+	}
+
+	items := make([]unstructured.Unstructured, 0)
+
+	for _, doc := range strings.Split(out.String(), "---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+
+		obj := unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(doc), &obj.Object); err != nil {
+			t.Fatalf("invalid output: %v", err)
+		}
+
+		items = append(items, obj)
+	}
+
+	return items, nil
+}
+
+func TestRenderCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifest  string
+		args      []string
+		namespace string
+	}{
+		{
+			name: "v1beta1",
+			manifest: `
+apiVersion: operator.dapr.io/v1beta1
+kind: DaprInstance
+metadata:
+  name: dapr-instance
+  namespace: dapr-system
+spec:
+  values:
+    dapr_operator:
+      logLevel: debug
+`,
+			namespace: "dapr-system",
+		},
+		{
+			name: "v1alpha1",
+			manifest: `
+apiVersion: operator.dapr.io/v1alpha1
+kind: DaprInstance
+metadata:
+  name: dapr-instance
+  namespace: dapr-system
+spec:
+  values:
+    dapr_operator:
+      logLevel: debug
+`,
+			namespace: "dapr-system",
+		},
+		{
+			name: "defaults",
+			manifest: `
+apiVersion: operator.dapr.io/v1beta1
+kind: DaprInstance
+spec:
+  values:
+    dapr_operator:
+      logLevel: debug
+`,
+			args:      []string{"--namespace", "dapr-test"},
+			namespace: "dapr-test",
+		},
+		{
+			name: "other documents",
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+---
+apiVersion: operator.dapr.io/v1beta1
+kind: DaprInstance
+metadata:
+  namespace: dapr-system
+spec:
+  values:
+    dapr_operator:
+      logLevel: debug
+`,
+			namespace: "dapr-system",
+		},
+		{
+			name: "OpenShift",
+			manifest: `
+apiVersion: operator.dapr.io/v1beta1
+kind: DaprInstance
+metadata:
+  namespace: dapr-system
+spec:
+  values:
+    dapr_operator:
+      logLevel: debug
+`,
+			args:      []string{"--cluster-type", "OpenShift"},
+			namespace: "dapr-system",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items, err := run(t, append([]string{"--file", manifest(t, tt.manifest)}, tt.args...)...)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(items).ToNot(BeEmpty())
+
+			found := false
+
+			for i := range items {
+				g.Expect(items[i].GetLabels()).To(HaveKeyWithValue(helm.ReleaseName, instance.DaprInstanceResourceName))
+				g.Expect(items[i].GetLabels()).To(HaveKeyWithValue(helm.ReleaseNamespace, tt.namespace))
+
+				// rendering offline, the owner references are never set
+				g.Expect(items[i].GetOwnerReferences()).To(BeEmpty())
+
+				if items[i].GetKind() != "Deployment" || items[i].GetName() != "dapr-operator" {
+					continue
+				}
+
+				found = true
+
+				containers, _, err := unstructured.NestedSlice(items[i].Object, "spec", "template", "spec", "containers")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(containers).ToNot(BeEmpty())
+				g.Expect(containers[0]).To(HaveKeyWithValue("args", ContainElement("debug")))
+			}
+
+			g.Expect(found).To(BeTrue())
+		})
+	}
+}
+
+func TestRenderCmdErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		args     []string
+		err      error
+	}{
+		{
+			name: "no DaprInstance",
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`,
+			err: render.ErrNoDaprInstance,
+		},
+		{
+			name: "unsupported cluster type",
+			manifest: `
+apiVersion: operator.dapr.io/v1beta1
+kind: DaprInstance
+`,
+			args: []string{"--cluster-type", "Unknown"},
+			err:  render.ErrUnsupportedClusterType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := run(t, append([]string{"--file", manifest(t, tt.manifest)}, tt.args...)...)
+			g.Expect(err).To(MatchError(tt.err))
+		})
+	}
+}
+
+func TestRenderCmdMissingFile(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := run(t, "--file", filepath.Join(t.TempDir(), "missing.yaml"))
+	g.Expect(err).To(MatchError(os.ErrNotExist))
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/dapr/kubernetes-operator/internal/controller/operator/controlplane"
 	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/storage"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
 	"github.com/dapr/kubernetes-operator/pkg/helm"
 
 	"github.com/spf13/cobra"
@@ -15,11 +17,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlRt "sigs.k8s.io/controller-runtime"
 	rtcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	rtclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	"github.com/dapr/kubernetes-operator/api/operator"
+	daprApiV1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	"github.com/dapr/kubernetes-operator/pkg/controller"
 )
 
@@ -36,6 +41,9 @@ func NewCmd() *cobra.Command {
 		EnableLeaderElection:          true,
 		ReleaseLeaderElectionOnCancel: true,
 		LeaderElectionNamespace:       "",
+		EnableWebhooks:                false,
+		WebhookPort:                   9443,
+		WebhookCertDir:                "",
 	}
 
 	helmOpts := helm.Options{
@@ -62,6 +70,18 @@ func NewCmd() *cobra.Command {
 					return fmt.Errorf("unable to set-up DaprInstance reconciler: %w", err)
 				}
 
+				// without the conversion webhook, rewriting the resources in the storage
+				// version would only change their version, not convert them
+				if opts.EnableWebhooks {
+					if err := setupWebhooks(manager, helmOpts); err != nil {
+						return err
+					}
+
+					if err := setupStorageMigrator(manager); err != nil {
+						return err
+					}
+				}
+
 				return nil
 			})
 		},
@@ -83,6 +103,13 @@ func NewCmd() *cobra.Command {
 	cmd.Flags().StringVar(
 		&co.PprofAddr, "pprof-bind-address", co.PprofAddr, "The address the pprof endpoint binds to.")
 
+	cmd.Flags().BoolVar(
+		&co.EnableWebhooks, "enable-webhooks", co.EnableWebhooks, "Enable the conversion and validation webhooks.")
+	cmd.Flags().IntVar(
+		&co.WebhookPort, "webhook-port", co.WebhookPort, "The port the webhook server binds to.")
+	cmd.Flags().StringVar(
+		&co.WebhookCertDir, "webhook-cert-dir", co.WebhookCertDir, "The directory containing the webhook server certificate.")
+
 	cmd.Flags().StringVar(
 		&helmOpts.ChartsDir, "helm-charts-dir", helmOpts.ChartsDir, "Helm charts dir.")
 
@@ -92,6 +119,7 @@ func NewCmd() *cobra.Command {
 //nolint:gochecknoinits
 func init() {
 	utilruntime.Must(daprApi.AddToScheme(controller.Scheme))
+	utilruntime.Must(daprApiV1alpha1.AddToScheme(controller.Scheme))
 	utilruntime.Must(apiextensions.AddToScheme(controller.Scheme))
 }
 
@@ -118,3 +146,50 @@ func computeListWatch() (map[rtclient.Object]rtcache.ByObject, error) {
 
 	return selectors, nil
 }
+
+// setupStorageMigrator rewrites the existing resources in the current storage version.
+func setupStorageMigrator(manager manager.Manager) error {
+	c, err := client.NewClient(manager.GetConfig(), manager.GetScheme(), manager.GetClient())
+	if err != nil {
+		return fmt.Errorf("unable to set-up storage version migrator: %w", err)
+	}
+
+	m := storage.NewMigrator(
+		c,
+		"daprinstances."+operator.Group,
+		"daprcontrolplanes."+operator.Group,
+		"daprcruiscontrols."+operator.Group,
+	)
+
+	if err := manager.Add(m); err != nil {
+		return fmt.Errorf("unable to set-up storage version migrator: %w", err)
+	}
+
+	return nil
+}
+
+// setupWebhooks serves the validation of the DaprInstance resources and the conversion
+// between the versions of the API, the hub being the version the controllers work with.
+func setupWebhooks(manager manager.Manager, helmOpts helm.Options) error {
+	c, err := client.NewClient(manager.GetConfig(), manager.GetScheme(), manager.GetClient())
+	if err != nil {
+		return fmt.Errorf("unable to set-up webhooks: %w", err)
+	}
+
+	if err := instance.NewValidator(c, helmOpts).SetupWithManager(manager); err != nil {
+		return fmt.Errorf("unable to set-up DaprInstance webhook: %w", err)
+	}
+
+	types := []rtclient.Object{
+		&daprApi.DaprControlPlane{},
+		&daprApi.DaprCruiseControl{},
+	}
+
+	for _, t := range types {
+		if err := ctrlRt.NewWebhookManagedBy(manager).For(t).Complete(); err != nil {
+			return fmt.Errorf("unable to set-up conversion webhook for %T: %w", t, err)
+		}
+	}
+
+	return nil
+}
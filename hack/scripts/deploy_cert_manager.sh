@@ -0,0 +1,19 @@
+#!/bin/sh
+
+set -e
+
+CERT_MANAGER_VERSION="${CERT_MANAGER_VERSION:-v1.18.2}"
+
+kubectl apply --server-side -f "https://github.com/cert-manager/cert-manager/releases/download/${CERT_MANAGER_VERSION}/cert-manager.yaml"
+
+# it may take a while to have apply the
+# resource, hence the kubectl wait may
+# fail
+sleep 5
+
+kubectl wait \
+  --namespace=cert-manager \
+  --for=condition=ready \
+  pod \
+  --selector=app.kubernetes.io/instance=cert-manager \
+  --timeout=90s
@@ -19,6 +19,9 @@ go run k8s.io/kube-openapi/cmd/openapi-gen \
   --output-dir "pkg/generated/openapi" \
   --output-pkg "github.com/dapr/kubernetes-operator/pkg/generated/openapi" \
   github.com/dapr/kubernetes-operator/api/operator/v1alpha1 \
+  github.com/dapr/kubernetes-operator/api/operator/v1beta1 \
+  k8s.io/api/core/v1 \
+  k8s.io/apimachinery/pkg/api/resource \
   k8s.io/apimachinery/pkg/apis/meta/v1 \
   k8s.io/apimachinery/pkg/runtime \
   k8s.io/apimachinery/pkg/version
@@ -29,7 +32,8 @@ go run k8s.io/code-generator/cmd/applyconfiguration-gen \
   --go-header-file="${PROJECT_ROOT}/hack/boilerplate.go.txt" \
   --output-dir="${TMP_DIR}/client/applyconfiguration" \
   --output-pkg=github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration \
-  github.com/dapr/kubernetes-operator/api/operator/v1alpha1
+  github.com/dapr/kubernetes-operator/api/operator/v1alpha1 \
+  github.com/dapr/kubernetes-operator/api/operator/v1beta1
 
 echo "Generate client"
 go run k8s.io/code-generator/cmd/client-gen \
@@ -37,7 +41,8 @@ go run k8s.io/code-generator/cmd/client-gen \
   --output-dir="${TMP_DIR}/client/clientset" \
   --input-base=github.com/dapr/kubernetes-operator/api \
   --input=operator/v1alpha1 \
-  --fake-clientset=false \
+  --input=operator/v1beta1 \
+  --fake-clientset=true \
   --clientset-name "versioned"  \
   --apply-configuration-package=github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration \
   --output-pkg=github.com/dapr/kubernetes-operator/pkg/client/clientset
@@ -47,7 +52,8 @@ go run k8s.io/code-generator/cmd/lister-gen \
   --go-header-file="${PROJECT_ROOT}/hack/boilerplate.go.txt" \
   --output-dir="${TMP_DIR}/client/listers" \
   --output-pkg=github.com/dapr/kubernetes-operator/pkg/client/listers \
-  github.com/dapr/kubernetes-operator/api/operator/v1alpha1
+  github.com/dapr/kubernetes-operator/api/operator/v1alpha1 \
+  github.com/dapr/kubernetes-operator/api/operator/v1beta1
 
 echo "Generate informer"
 go run k8s.io/code-generator/cmd/informer-gen \
@@ -56,7 +62,8 @@ go run k8s.io/code-generator/cmd/informer-gen \
   --versioned-clientset-package=github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned \
   --listers-package=github.com/dapr/kubernetes-operator/pkg/client/listers \
   --output-pkg=github.com/dapr/kubernetes-operator/pkg/client/informers \
-  github.com/dapr/kubernetes-operator/api/operator/v1alpha1
+  github.com/dapr/kubernetes-operator/api/operator/v1alpha1 \
+  github.com/dapr/kubernetes-operator/api/operator/v1beta1
 
 # This should not be needed but for some reasons, the applyconfiguration-gen tool
 # sets a wrong APIVersion for the Dapr type (operator/v1alpha1 instead of the one with
@@ -72,6 +79,15 @@ sed -i \
 sed -i \
   's/WithAPIVersion(\"operator\/v1alpha1\")/WithAPIVersion(\"operator.dapr.io\/v1alpha1\")/g' \
   "${TMP_DIR}"/client/applyconfiguration/operator/v1alpha1/daprcruisecontrol.go
+sed -i \
+  's/WithAPIVersion(\"operator\/v1beta1\")/WithAPIVersion(\"operator.dapr.io\/v1beta1\")/g' \
+  "${TMP_DIR}"/client/applyconfiguration/operator/v1beta1/daprcontrolplane.go
+sed -i \
+  's/WithAPIVersion(\"operator\/v1beta1\")/WithAPIVersion(\"operator.dapr.io\/v1beta1\")/g' \
+  "${TMP_DIR}"/client/applyconfiguration/operator/v1beta1/daprinstance.go
+sed -i \
+  's/WithAPIVersion(\"operator\/v1beta1\")/WithAPIVersion(\"operator.dapr.io\/v1beta1\")/g' \
+  "${TMP_DIR}"/client/applyconfiguration/operator/v1beta1/daprcruisecontrol.go
 
 cp -r \
   "${TMP_DIR}"/client/* \
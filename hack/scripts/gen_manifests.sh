@@ -9,5 +9,7 @@ PROJECT_ROOT="$1"
 go run sigs.k8s.io/controller-tools/cmd/controller-gen \
   rbac:roleName=dapr-control-plane-role \
   crd \
+  webhook \
   paths="{./api/operator/...,./internal/...}" \
-  output:crd:artifacts:config="${PROJECT_ROOT}/config/crd/bases"
+  output:crd:artifacts:config="${PROJECT_ROOT}/config/crd/bases" \
+  output:webhook:artifacts:config="${PROJECT_ROOT}/config/webhook"
@@ -28,10 +28,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 
 	"github.com/dapr/kubernetes-operator/pkg/controller"
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/controller/predicates"
 	"github.com/dapr/kubernetes-operator/pkg/controller/reconciler"
 	"github.com/dapr/kubernetes-operator/pkg/helm"
 	"github.com/go-logr/logr"
@@ -55,6 +56,7 @@ func NewReconciler(ctx context.Context, manager ctrlRt.Manager, o helm.Options)
 	rec.recorder = manager.GetEventRecorderFor(controller.FieldManager)
 
 	rec.actions = append(rec.actions, NewApplyAction(rec.l))
+	rec.actions = append(rec.actions, NewMigrateAction(rec.l))
 	rec.actions = append(rec.actions, NewStatusAction(rec.l))
 
 	err = rec.init(ctx)
@@ -91,6 +93,7 @@ func (r *Reconciler) init(ctx context.Context) error {
 	c = c.For(&daprApi.DaprControlPlane{}, builder.WithPredicates(
 		predicate.Or(
 			predicate.GenerationChangedPredicate{},
+			predicates.AnnotationChanged{Name: DaprControlPlaneSkipMigrationAnnotation},
 		)))
 
 	for i := range r.actions {
@@ -2,17 +2,8 @@ package controlplane
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/dapr/kubernetes-operator/pkg/controller"
-
-	"github.com/dapr/kubernetes-operator/pkg/resources"
-
-	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
-	daprAc "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
 	"github.com/dapr/kubernetes-operator/pkg/controller/predicates"
@@ -44,28 +35,13 @@ func (a *ApplyAction) Configure(_ context.Context, _ *client.Client, b *builder.
 }
 
 func (a *ApplyAction) Run(ctx context.Context, rr *ReconciliationRequest) error {
-	spec := daprAc.DaprInstanceSpec()
-
-	if rr.Resource.Spec.Values != nil {
-		values := daprAc.JSON()
-		values.RawMessage = rr.Resource.Spec.Values.RawMessage
+	if migrated(rr) {
+		a.l.Info("run", "skip", "true", "reason", "migrated")
 
-		spec = spec.WithValues(values)
+		return nil
 	}
 
-	_, err := rr.Dapr.OperatorV1alpha1().DaprInstances(rr.Resource.Namespace).Apply(
-		ctx,
-		daprAc.DaprInstance(instance.DaprInstanceResourceName, rr.Resource.Namespace).
-			WithOwnerReferences(resources.WithOwnerReference(rr.Resource)).
-			WithSpec(spec),
-		metav1.ApplyOptions{
-			FieldManager: controller.FieldManager,
-		})
-	if err != nil {
-		return fmt.Errorf("failure to apply changes to %s: %w", rr.NamespacedName, err)
-	}
-
-	return nil
+	return applyInstance(ctx, rr, true)
 }
 
 func (a *ApplyAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
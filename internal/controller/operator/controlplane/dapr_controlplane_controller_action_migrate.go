@@ -0,0 +1,168 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewMigrateAction(l logr.Logger) Action {
+	return &MigrateAction{
+		l: l.WithName("action").WithName("migrate"),
+	}
+}
+
+// MigrateAction migrates a DaprControlPlane to a standalone DaprInstance.
+//
+// Once the DaprInstance mirroring the DaprControlPlane has been reconciled, the owner
+// reference to the DaprControlPlane is removed. The spec of the DaprInstance is left as it
+// is, so the control plane is neither re-rendered nor restarted. From then on, the
+// DaprControlPlane is not reconciled anymore and can be safely deleted, the DaprInstance
+// being managed directly. Changes to the spec of a migrated DaprControlPlane are ignored,
+// and reported as such with a warning event.
+//
+// The migration is recorded on the DaprControlPlane with the generation that has been
+// migrated, as the Migrated condition would be lost along with the status:
+//
+// - operator.dapr.io/migrated-generation: ${generation}
+//
+// The migration can be disabled by annotating the DaprControlPlane with:
+//
+// - operator.dapr.io/skip-migration: "true"
+//
+// The action MUST be executed after the DaprInstance has been applied.
+type MigrateAction struct {
+	l logr.Logger
+}
+
+func (a *MigrateAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *MigrateAction) Run(ctx context.Context, rr *ReconciliationRequest) error {
+	if generation, ok := migratedGeneration(rr); ok {
+		a.migrated(rr, generation)
+
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeMigrated,
+		Status:             metav1.ConditionFalse,
+		Reason:             conditions.ReasonMigrationPending,
+		ObservedGeneration: rr.Resource.Generation,
+	}
+
+	if skipMigration(rr) {
+		condition.Reason = conditions.ReasonMigrationDisabled
+		condition.Message = fmt.Sprintf("migration disabled by the %s annotation", DaprControlPlaneSkipMigrationAnnotation)
+
+		meta.SetStatusCondition(&rr.Resource.Status.Conditions, condition)
+
+		return nil
+	}
+
+	di, err := rr.Dapr.OperatorV1beta1().DaprInstances(rr.Resource.Namespace).Get(
+		ctx,
+		instance.DaprInstanceResourceName,
+		metav1.GetOptions{},
+	)
+
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failure to lookup resource %s: %w", rr.NamespacedName, err)
+	}
+
+	// the DaprInstance must have been reconciled with the spec of the DaprControlPlane, so
+	// that releasing it does not result in any change to the control plane
+	if k8serrors.IsNotFound(err) ||
+		di.Status.ObservedGeneration != di.Generation ||
+		!meta.IsStatusConditionTrue(di.Status.Conditions, conditions.TypeReconciled) {
+		condition.Message = "waiting for DaprInstance " + instance.DaprInstanceResourceName + " to be reconciled"
+
+		meta.SetStatusCondition(&rr.Resource.Status.Conditions, condition)
+
+		return nil
+	}
+
+	if err := applyInstance(ctx, rr, false); err != nil {
+		return err
+	}
+
+	if err := markMigrated(ctx, rr); err != nil {
+		return err
+	}
+
+	a.l.Info("run", "migrated", instance.DaprInstanceResourceName)
+
+	meta.SetStatusCondition(&rr.Resource.Status.Conditions, migratedCondition(rr))
+
+	rr.Reconciler.Event(
+		rr.Resource,
+		corev1.EventTypeNormal,
+		conditions.ReasonMigrated,
+		fmt.Sprintf("Migrated to the standalone DaprInstance %s", instance.DaprInstanceResourceName),
+	)
+
+	return nil
+}
+
+// migrated reports the state of a migrated DaprControlPlane, the changes made to its spec
+// after the given migrated generation are reported once per generation.
+func (a *MigrateAction) migrated(rr *ReconciliationRequest, generation int64) {
+	if rr.Resource.Generation <= generation {
+		// restore the condition in case the status has been lost
+		meta.SetStatusCondition(&rr.Resource.Status.Conditions, migratedCondition(rr))
+
+		return
+	}
+
+	condition := meta.FindStatusCondition(rr.Resource.Status.Conditions, conditions.TypeMigrated)
+	if condition != nil &&
+		condition.Reason == conditions.ReasonMigratedSpecIgnored &&
+		condition.ObservedGeneration >= rr.Resource.Generation {
+		return
+	}
+
+	message := fmt.Sprintf("the spec changed after the migration to the standalone DaprInstance %s and is ignored, "+
+		"changes must be applied to the DaprInstance", instance.DaprInstanceResourceName)
+
+	meta.SetStatusCondition(&rr.Resource.Status.Conditions, metav1.Condition{
+		Type:               conditions.TypeMigrated,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditions.ReasonMigratedSpecIgnored,
+		Message:            message,
+		ObservedGeneration: rr.Resource.Generation,
+	})
+
+	rr.Reconciler.Event(
+		rr.Resource,
+		corev1.EventTypeWarning,
+		conditions.ReasonMigratedSpecIgnored,
+		"Changes to the spec are ignored as the DaprControlPlane has been migrated to the standalone DaprInstance "+
+			instance.DaprInstanceResourceName,
+	)
+}
+
+func migratedCondition(rr *ReconciliationRequest) metav1.Condition {
+	return metav1.Condition{
+		Type:   conditions.TypeMigrated,
+		Status: metav1.ConditionTrue,
+		Reason: conditions.ReasonMigrated,
+		Message: fmt.Sprintf("migrated to the standalone DaprInstance %s, the DaprControlPlane can be deleted",
+			instance.DaprInstanceResourceName),
+		ObservedGeneration: rr.Resource.Generation,
+	}
+}
+
+func (a *MigrateAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
@@ -0,0 +1,249 @@
+package controlplane_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/controlplane"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	daprFake "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned/fake"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const namespace = "dapr-system"
+
+func scheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+
+	utilruntime.Must(clientgoscheme.AddToScheme(s))
+	utilruntime.Must(daprApi.AddToScheme(s))
+
+	return s
+}
+
+func daprControlPlane(generation int64, annotations map[string]string) *daprApi.DaprControlPlane {
+	return &daprApi.DaprControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: daprApi.GroupVersion.String(),
+			Kind:       "DaprControlPlane",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        controlplane.DaprControlPlaneResourceName,
+			Namespace:   namespace,
+			Generation:  generation,
+			Annotations: annotations,
+			UID:         types.UID("dcp"),
+		},
+	}
+}
+
+func daprInstance(reconciled bool) *daprApi.DaprInstance {
+	di := daprApi.DaprInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       instance.DaprInstanceResourceName,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+	}
+
+	if reconciled {
+		di.Status.ObservedGeneration = di.Generation
+		di.Status.Conditions = []metav1.Condition{{
+			Type:   conditions.TypeReconciled,
+			Status: metav1.ConditionTrue,
+			Reason: conditions.ReasonReconciled,
+		}}
+	}
+
+	return &di
+}
+
+type fixture struct {
+	rr       controlplane.ReconciliationRequest
+	dapr     *daprFake.Clientset
+	recorder *record.FakeRecorder
+}
+
+func newFixture(dcp *daprApi.DaprControlPlane, objects ...runtime.Object) *fixture {
+	s := scheme()
+
+	dc := daprFake.NewClientset(objects...)
+	cc := ctrlFake.NewClientBuilder().WithScheme(s).WithObjects(dcp).WithStatusSubresource(dcp).Build()
+
+	c := client.NewClientFor(s, cc, kubeFake.NewClientset(), nil, nil)
+	c.Dapr = dc
+
+	recorder := record.NewFakeRecorder(10)
+
+	return &fixture{
+		rr: controlplane.ReconciliationRequest{
+			Client:         c,
+			NamespacedName: types.NamespacedName{Name: dcp.Name, Namespace: dcp.Namespace},
+			Reconciler:     controlplane.NewTestReconciler(c, recorder),
+			Resource:       dcp,
+		},
+		dapr:     dc,
+		recorder: recorder,
+	}
+}
+
+// run runs the MigrateAction and returns the events it emitted.
+func (f *fixture) run(t *testing.T) []string {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	g.Expect(controlplane.NewMigrateAction(logr.Discard()).Run(context.Background(), &f.rr)).To(Succeed())
+
+	events := make([]string, 0)
+
+	for len(f.recorder.Events) > 0 {
+		events = append(events, <-f.recorder.Events)
+	}
+
+	return events
+}
+
+// stored returns the DaprControlPlane as it is stored in the cluster.
+func (f *fixture) stored(t *testing.T) *daprApi.DaprControlPlane {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	dcp := daprApi.DaprControlPlane{}
+	g.Expect(f.rr.Client.Get(context.Background(), ctrlCli.ObjectKeyFromObject(f.rr.Resource), &dcp)).To(Succeed())
+
+	return &dcp
+}
+
+func TestMigrateAction(t *testing.T) {
+	g := NewWithT(t)
+
+	f := newFixture(daprControlPlane(1, nil), daprInstance(true))
+
+	events := f.run(t)
+
+	g.Expect(events).To(ConsistOf(ContainSubstring("Normal " + conditions.ReasonMigrated)))
+	g.Expect(f.rr.Resource.Annotations).To(HaveKeyWithValue(controlplane.DaprControlPlaneMigratedAnnotation, "1"))
+	g.Expect(f.stored(t).Annotations).To(HaveKeyWithValue(controlplane.DaprControlPlaneMigratedAnnotation, "1"))
+	g.Expect(f.rr.Resource.ResourceVersion).To(Equal(f.stored(t).ResourceVersion))
+
+	condition := meta.FindStatusCondition(f.rr.Resource.Status.Conditions, conditions.TypeMigrated)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(conditions.ReasonMigrated))
+	g.Expect(condition.ObservedGeneration).To(Equal(int64(1)))
+
+	t.Run("idempotent", func(t *testing.T) {
+		g := NewWithT(t)
+
+		f.dapr.ClearActions()
+
+		g.Expect(f.run(t)).To(BeEmpty())
+		g.Expect(f.dapr.Actions()).To(BeEmpty())
+		g.Expect(f.stored(t).Annotations).To(HaveKeyWithValue(controlplane.DaprControlPlaneMigratedAnnotation, "1"))
+		g.Expect(meta.FindStatusCondition(f.rr.Resource.Status.Conditions, conditions.TypeMigrated)).To(
+			HaveField("Reason", conditions.ReasonMigrated))
+	})
+
+	t.Run("spec changed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		f.rr.Resource.Generation = 2
+
+		g.Expect(f.run(t)).To(ConsistOf(ContainSubstring("Warning " + conditions.ReasonMigratedSpecIgnored)))
+		g.Expect(f.dapr.Actions()).To(BeEmpty())
+
+		condition := meta.FindStatusCondition(f.rr.Resource.Status.Conditions, conditions.TypeMigrated)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(condition.Reason).To(Equal(conditions.ReasonMigratedSpecIgnored))
+		g.Expect(condition.ObservedGeneration).To(Equal(int64(2)))
+
+		// reported once per generation
+		g.Expect(f.run(t)).To(BeEmpty())
+
+		f.rr.Resource.Generation = 3
+
+		g.Expect(f.run(t)).To(ConsistOf(ContainSubstring("Warning " + conditions.ReasonMigratedSpecIgnored)))
+		g.Expect(f.stored(t).Annotations).To(HaveKeyWithValue(controlplane.DaprControlPlaneMigratedAnnotation, "1"))
+	})
+}
+
+func TestMigrateActionStatusLost(t *testing.T) {
+	g := NewWithT(t)
+
+	f := newFixture(daprControlPlane(1, map[string]string{
+		controlplane.DaprControlPlaneMigratedAnnotation: "1",
+	}))
+
+	g.Expect(f.run(t)).To(BeEmpty())
+	g.Expect(f.dapr.Actions()).To(BeEmpty())
+
+	condition := meta.FindStatusCondition(f.rr.Resource.Status.Conditions, conditions.TypeMigrated)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(conditions.ReasonMigrated))
+}
+
+func TestMigrateActionNotMigrated(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		objects     []runtime.Object
+		reason      string
+	}{
+		{
+			name:   "missing instance",
+			reason: conditions.ReasonMigrationPending,
+		},
+		{
+			name:    "instance not reconciled",
+			objects: []runtime.Object{daprInstance(false)},
+			reason:  conditions.ReasonMigrationPending,
+		},
+		{
+			name:        "skipped",
+			annotations: map[string]string{controlplane.DaprControlPlaneSkipMigrationAnnotation: "true"},
+			objects:     []runtime.Object{daprInstance(true)},
+			reason:      conditions.ReasonMigrationDisabled,
+		},
+		{
+			name:        "invalid migrated generation",
+			annotations: map[string]string{controlplane.DaprControlPlaneMigratedAnnotation: "foo"},
+			reason:      conditions.ReasonMigrationPending,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			f := newFixture(daprControlPlane(1, test.annotations), test.objects...)
+
+			g.Expect(f.run(t)).To(BeEmpty())
+			g.Expect(f.stored(t).Annotations).ToNot(HaveKeyWithValue(controlplane.DaprControlPlaneMigratedAnnotation, "1"))
+
+			condition := meta.FindStatusCondition(f.rr.Resource.Status.Conditions, conditions.TypeMigrated)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(test.reason))
+		})
+	}
+}
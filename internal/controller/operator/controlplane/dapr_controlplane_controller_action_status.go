@@ -21,6 +21,8 @@ func NewStatusAction(l logr.Logger) Action {
 }
 
 // StatusAction computes the state of a DaprControlPlane resource out of the owned DaprInstance resource.
+// Once the DaprControlPlane has been migrated, the DaprInstance is not owned anymore and its
+// state is not reflected.
 type StatusAction struct {
 	l logr.Logger
 }
@@ -30,7 +32,11 @@ func (a *StatusAction) Configure(_ context.Context, _ *client.Client, b *builder
 }
 
 func (a *StatusAction) Run(ctx context.Context, rr *ReconciliationRequest) error {
-	di, err := rr.Dapr.OperatorV1alpha1().DaprInstances(rr.Resource.Namespace).Get(
+	if migrated(rr) {
+		return nil
+	}
+
+	di, err := rr.Dapr.OperatorV1beta1().DaprInstances(rr.Resource.Namespace).Get(
 		ctx,
 		instance.DaprInstanceResourceName,
 		metav1.GetOptions{},
@@ -1 +1,131 @@
 package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
+
+	daprAc "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+)
+
+// applyInstance applies the DaprInstance equivalent to the DaprControlPlane. When owned is
+// false, the owner reference previously set by the controller is dropped, leaving the spec
+// untouched, so the DaprInstance is not re-rendered.
+func applyInstance(ctx context.Context, rr *ReconciliationRequest, owned bool) error {
+	spec := daprAc.DaprInstanceSpec()
+
+	if rr.Resource.Spec.Chart != nil {
+		chart := daprAc.ChartSpec().
+			WithRepo(rr.Resource.Spec.Chart.Repo).
+			WithName(rr.Resource.Spec.Chart.Name)
+
+		if rr.Resource.Spec.Chart.Version != "" {
+			chart = chart.WithVersion(rr.Resource.Spec.Chart.Version)
+		}
+
+		if rr.Resource.Spec.Chart.Secret != "" {
+			chart = chart.WithSecret(rr.Resource.Spec.Chart.Secret)
+		}
+
+		if rr.Resource.Spec.Chart.ResolveInterval != nil {
+			chart = chart.WithResolveInterval(*rr.Resource.Spec.Chart.ResolveInterval)
+		}
+
+		spec = spec.WithChart(chart)
+	}
+
+	if rr.Resource.Spec.Values != nil {
+		values := daprAc.JSON()
+		values.RawMessage = rr.Resource.Spec.Values.RawMessage
+
+		spec = spec.WithValues(values)
+	}
+
+	di := daprAc.DaprInstance(instance.DaprInstanceResourceName, rr.Resource.Namespace).
+		WithSpec(spec)
+
+	if owned {
+		di = di.WithOwnerReferences(resources.WithOwnerReference(rr.Resource))
+	}
+
+	_, err := rr.Dapr.OperatorV1beta1().DaprInstances(rr.Resource.Namespace).Apply(
+		ctx,
+		di,
+		metav1.ApplyOptions{
+			FieldManager: controller.FieldManager,
+		})
+	if err != nil {
+		return fmt.Errorf("failure to apply changes to %s: %w", rr.NamespacedName, err)
+	}
+
+	return nil
+}
+
+// migrated returns true if the DaprControlPlane has been migrated to a standalone
+// DaprInstance, in which case it is not reconciled anymore.
+func migrated(rr *ReconciliationRequest) bool {
+	_, ok := migratedGeneration(rr)
+
+	return ok
+}
+
+// migratedGeneration returns the generation of the DaprControlPlane that has been migrated.
+func migratedGeneration(rr *ReconciliationRequest) (int64, bool) {
+	v, ok := rr.Resource.GetAnnotations()[DaprControlPlaneMigratedAnnotation]
+	if !ok {
+		return 0, false
+	}
+
+	g, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return g, true
+}
+
+// markMigrated records the migration of the current generation of the DaprControlPlane with
+// the DaprControlPlaneMigratedAnnotation annotation.
+func markMigrated(ctx context.Context, rr *ReconciliationRequest) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				DaprControlPlaneMigratedAnnotation: strconv.FormatInt(rr.Resource.Generation, 10),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal patch: %w", err)
+	}
+
+	// the status computed so far must not be overridden by the one of the patched object
+	obj := rr.Resource.DeepCopy()
+
+	if err := rr.Client.Patch(ctx, obj, ctrlCli.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("failure to annotate %s: %w", rr.NamespacedName, err)
+	}
+
+	rr.Resource.SetAnnotations(obj.GetAnnotations())
+	rr.Resource.SetResourceVersion(obj.GetResourceVersion())
+
+	return nil
+}
+
+func skipMigration(rr *ReconciliationRequest) bool {
+	v, ok := rr.Resource.GetAnnotations()[DaprControlPlaneSkipMigrationAnnotation]
+	if !ok {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+
+	return err == nil && b
+}
@@ -3,7 +3,7 @@ package controlplane
 import (
 	"context"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -12,6 +12,15 @@ import (
 const (
 	DaprControlPlaneFinalizerName = "controlplane.operator.dapr.io/finalizer"
 	DaprControlPlaneResourceName  = "dapr-control-plane"
+
+	// DaprControlPlaneSkipMigrationAnnotation prevents the DaprControlPlane from being
+	// migrated to a standalone DaprInstance when set to true.
+	DaprControlPlaneSkipMigrationAnnotation = "operator.dapr.io/skip-migration"
+
+	// DaprControlPlaneMigratedAnnotation records the generation of the DaprControlPlane that
+	// has been migrated to a standalone DaprInstance, so the migration is not reverted if the
+	// status is lost, e.g. when the resource is restored from a backup.
+	DaprControlPlaneMigratedAnnotation = "operator.dapr.io/migrated-generation"
 )
 
 type ReconciliationRequest struct {
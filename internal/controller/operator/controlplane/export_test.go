@@ -0,0 +1,16 @@
+package controlplane //nolint:testpackage
+
+import (
+	"k8s.io/client-go/tools/record"
+
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+)
+
+// The unexported functions and types below are exported to the controlplane_test package only.
+
+func NewTestReconciler(c *client.Client, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		client:   c,
+		recorder: recorder,
+	}
+}
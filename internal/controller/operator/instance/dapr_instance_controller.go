@@ -33,10 +33,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 
 	"github.com/dapr/kubernetes-operator/pkg/controller"
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/controller/predicates"
 	"github.com/dapr/kubernetes-operator/pkg/controller/reconciler"
 	"github.com/dapr/kubernetes-operator/pkg/helm"
 	"github.com/dapr/kubernetes-operator/pkg/openshift"
@@ -63,6 +64,7 @@ func NewReconciler(ctx context.Context, manager ctrlRt.Manager, o helm.Options)
 	rec.recorder = manager.GetEventRecorderFor(controller.FieldManager)
 	rec.helmOptions = o
 	rec.helmEngine = helme.New()
+	rec.helmCharts = newHelmCharts()
 
 	isOpenshift, err := openshift.IsOpenShift(c.Discovery)
 	if err != nil {
@@ -75,8 +77,17 @@ func NewReconciler(ctx context.Context, manager ctrlRt.Manager, o helm.Options)
 	}
 
 	rec.actions = append(rec.actions, NewChartAction(rec.l))
+	rec.actions = append(rec.actions, NewChartUpdatesAction(rec.l))
+	rec.actions = append(rec.actions, NewValidateValuesAction(rec.l))
+	rec.actions = append(rec.actions, NewValidateConversionAction(rec.l))
+	rec.actions = append(rec.actions, NewPatchesAction(rec.l))
+	rec.actions = append(rec.actions, NewValidatePodSecurityAction(rec.l))
+	rec.actions = append(rec.actions, NewValidateUpgradeAction(rec.l))
+	rec.actions = append(rec.actions, NewMaintenanceAction(rec.l))
+	rec.actions = append(rec.actions, NewApprovalAction(rec.l))
 	rec.actions = append(rec.actions, NewApplyCRDsAction(rec.l))
 	rec.actions = append(rec.actions, NewApplyResourcesAction(rec.l))
+	rec.actions = append(rec.actions, NewHelmReleaseAction(rec.l))
 	rec.actions = append(rec.actions, NewConditionsAction(rec.l))
 	rec.actions = append(rec.actions, NewGCAction(rec.l))
 
@@ -106,6 +117,10 @@ func NewReconciler(ctx context.Context, manager ctrlRt.Manager, o helm.Options)
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=*
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=*
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=*
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=*
+// +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=*
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=*
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes/custom-host,verbs=create
 // +kubebuilder:rbac:groups=dapr.io,resources=components,verbs=*
 // +kubebuilder:rbac:groups=dapr.io,resources=components/status,verbs=*
 // +kubebuilder:rbac:groups=dapr.io,resources=components/finalizers,verbs=*
@@ -126,6 +141,7 @@ type Reconciler struct {
 	actions     []Action
 	l           logr.Logger
 	helmEngine  *helme.Instance
+	helmCharts  *helmCharts
 	helmOptions helm.Options
 	manager     ctrlRt.Manager
 	controller  ctrl.Controller
@@ -142,6 +158,9 @@ func (r *Reconciler) init(ctx context.Context) error {
 	c = c.For(&daprApi.DaprInstance{}, builder.WithPredicates(
 		predicate.Or(
 			predicate.GenerationChangedPredicate{},
+			predicates.AnnotationChanged{Name: DaprInstanceUpgradeOverrideAnnotation},
+			predicates.AnnotationChanged{Name: DaprInstanceMaintenanceOverrideAnnotation},
+			predicates.AnnotationChanged{Name: DaprInstanceApprovedPlanAnnotation},
 		)))
 
 	for i := range r.actions {
@@ -37,7 +37,9 @@ func (a *ApplyCRDsAction) Configure(_ context.Context, _ *client.Client, b *buil
 }
 
 func (a *ApplyCRDsAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
-	if rc.Resource.Generation == rc.Resource.Status.ObservedGeneration {
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
 		return nil
 	}
 
@@ -46,13 +48,17 @@ func (a *ApplyCRDsAction) Run(ctx context.Context, rc *ReconciliationRequest) er
 		return fmt.Errorf("cannot load chart: %w", err)
 	}
 
+	if rc.Resource.Generation == rc.Resource.Status.ObservedGeneration && !rc.ChartChanged(c) {
+		return nil
+	}
+
 	crds, err := c.CRDObjects()
 	if err != nil {
 		return fmt.Errorf("cannot load CRDs: %w", err)
 	}
 
 	invalidate := false
-	force := rc.Resource.Generation != rc.Resource.Status.ObservedGeneration || !helm.IsSameChart(c, rc.Resource.Status.Chart)
+	force := rc.Resource.Generation != rc.Resource.Status.ObservedGeneration || rc.ChartChanged(c)
 
 	for _, crd := range crds {
 		resources.Labels(&crd, map[string]string{
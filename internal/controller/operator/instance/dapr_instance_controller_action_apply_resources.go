@@ -3,8 +3,6 @@ package instance
 import (
 	"context"
 	"fmt"
-	"sort"
-	"strconv"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -19,9 +17,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
-	"github.com/dapr/kubernetes-operator/pkg/helm"
 	"github.com/dapr/kubernetes-operator/pkg/pointer"
 	"github.com/dapr/kubernetes-operator/pkg/resources"
 )
@@ -32,12 +29,15 @@ func NewApplyResourcesAction(l logr.Logger) Action {
 		subscriptions: make(map[string]struct{}),
 	}
 
+	action.upgrade = newUpgradeOrchestrator(action.l)
+
 	return &action
 }
 
 type ApplyResourcesAction struct {
 	l             logr.Logger
 	subscriptions map[string]struct{}
+	upgrade       *upgradeOrchestrator
 }
 
 func (a *ApplyResourcesAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
@@ -45,25 +45,30 @@ func (a *ApplyResourcesAction) Configure(_ context.Context, _ *client.Client, b
 }
 
 func (a *ApplyResourcesAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
 	c, err := rc.Chart(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot load chart: %w", err)
 	}
 
-	items, err := c.Render(ctx, rc.Resource.Name, rc.Resource.Namespace, int(rc.Resource.Generation), rc.Helm.ChartValues)
+	items, err := rc.Render(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot render a chart: %w", err)
 	}
 
-	// TODO: this must be ordered by priority/relations
-	sort.Slice(items, func(i int, j int) bool {
-		istr := items[i].GroupVersionKind().Kind + ":" + items[i].GetName()
-		jstr := items[j].GroupVersionKind().Kind + ":" + items[j].GetName()
+	digest, err := workloadsDigest(items)
+	if err != nil {
+		return fmt.Errorf("cannot compute workloads digest: %w", err)
+	}
 
-		return istr < jstr
-	})
+	sortResources(items)
 
-	force := rc.Resource.Generation != rc.Resource.Status.ObservedGeneration || !helm.IsSameChart(c, rc.Resource.Status.Chart)
+	force := rc.Resource.Generation != rc.Resource.Status.ObservedGeneration || rc.ChartChanged(c)
 
 	if force {
 		rc.Reconciler.Event(
@@ -73,41 +78,89 @@ func (a *ApplyResourcesAction) Run(ctx context.Context, rc *ReconciliationReques
 			fmt.Sprintf("Render full Helm template (observedGeneration: %d, generation: %d, installedChart: %v, chart: %v)",
 				rc.Resource.Status.ObservedGeneration,
 				rc.Resource.Generation,
-				rc.Resource.Status.Chart,
+				rc.InstalledChart,
 				c.Spec()),
 		)
 	}
 
+	blocking, err := blockingDisruptionBudgets(rc, items)
+	if err != nil {
+		return err
+	}
+
+	// PodDisruptionBudgets rendered by the chart or added by the patches are not rejected if the
+	// validation webhook is not deployed, so they are reported when the resources are re-rendered
+	if force && len(blocking) > 0 {
+		rc.Reconciler.Event(
+			rc.Resource,
+			corev1.EventTypeWarning,
+			"BlockingPodDisruptionBudget",
+			"PodDisruptionBudgets block node drains: "+disruptionBudgetsSummary(blocking),
+		)
+	}
+
+	workloads := make([]unstructured.Unstructured, 0)
+
 	for _, obj := range items {
-		resources.Labels(&obj, map[string]string{
-			helm.ReleaseGeneration: strconv.FormatInt(rc.Resource.Generation, 10),
-			helm.ReleaseName:       rc.Resource.Name,
-			helm.ReleaseNamespace:  rc.Resource.Namespace,
-			helm.ReleaseVersion:    c.Version(),
-		})
+		resources.Labels(&obj, releaseLabels(rc, c.Version()))
 
 		gvk := obj.GroupVersionKind()
 
-		if !force {
-			force = !a.installOnly(gvk)
+		// workloads are applied last and, in case of an upgrade, rolled out in
+		// a specific order by the upgrade orchestrator
+		if isWorkload(gvk) {
+			workloads = append(workloads, obj)
+
+			continue
+		}
+
+		// install-only resources are applied only when forced, unless the cluster profile
+		// has replaced the values that are generated at each rendering
+		reconcile := force || !a.installOnly(gvk) || profileFor(rc.ClusterType).Reconcilable(&obj)
+
+		// the value of a PriorityClass cannot be changed, it is re-created instead before the
+		// workloads are applied
+		if isPriorityClass(&obj) {
+			if err := replacePriorityClass(ctx, rc, &obj); err != nil {
+				return err
+			}
 		}
 
-		err = a.apply(ctx, rc, &obj, force)
+		err = a.apply(ctx, rc, &obj, reconcile)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
-}
+	err = a.upgrade.Run(ctx, rc, c.Version(), workloads, func(obj *unstructured.Unstructured) error {
+		if autoscaled(rc, obj) {
+			if err := handoverReplicas(ctx, rc, obj); err != nil {
+				return err
+			}
+		}
 
-func (a *ApplyResourcesAction) Cleanup(ctx context.Context, rc *ReconciliationRequest) error {
-	c, err := rc.Chart(ctx)
+		return a.apply(ctx, rc, obj, true)
+	})
 	if err != nil {
-		return fmt.Errorf("cannot load chart: %w", err)
+		return err
 	}
 
-	items, err := c.Render(ctx, rc.Resource.Name, rc.Resource.Namespace, int(rc.Resource.Generation), rc.Helm.ChartValues)
+	sizes, err := workloadsSizing(workloads)
+	if err != nil {
+		return err
+	}
+
+	rc.Resource.Status.WorkloadsDigest = digest
+	rc.Resource.Status.Sizing = &daprApi.SizingStatus{
+		Profile:   rc.Resource.Spec.Profile,
+		Workloads: sizes,
+	}
+
+	return nil
+}
+
+func (a *ApplyResourcesAction) Cleanup(ctx context.Context, rc *ReconciliationRequest) error {
+	items, err := rc.Render(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot render a chart: %w", err)
 	}
@@ -154,11 +207,7 @@ func (a *ApplyResourcesAction) watchForUpdates(gvk schema.GroupVersionKind) bool
 }
 
 func (a *ApplyResourcesAction) watchStatus(gvk schema.GroupVersionKind) bool {
-	if gvk.Group == "apps" && gvk.Version == "v1" && gvk.Kind == "Deployment" {
-		return true
-	}
-
-	return false
+	return isWorkload(gvk)
 }
 
 func (a *ApplyResourcesAction) installOnly(gvk schema.GroupVersionKind) bool {
@@ -218,14 +267,17 @@ func (a *ApplyResourcesAction) apply(ctx context.Context, rc *ReconciliationRequ
 
 		if old != nil {
 			//
-			// Every time the template is rendered, the helm function genSignedCert kicks in and
-			// re-generated certs which causes a number os side effects and makes the set-up quite
-			// unstable. As consequence some resources are not meant to be watched and re-created
-			// unless the Dapr CR generation changes (which means the Spec has changed) or the
-			// resource impacted by the genSignedCert hook is deleted.
+			// Every time the template of older charts is rendered, the helm function
+			// genSignedCert kicks in and re-generates the certificate of the sidecar injector,
+			// which causes a number of side effects and makes the set-up quite unstable. As
+			// consequence some resources are not meant to be watched and re-created unless the
+			// Dapr CR generation changes (which means the Spec has changed) or they are deleted.
 			//
-			// Ideally on OpenShift it would be good to leverage the service serving certificates
-			// capability.
+			// On OpenShift, the certificate generated by such charts is replaced by a service
+			// serving certificate and the webhook configuration is reconciled continuously, see
+			// openShiftProfile. The sidecar injector of recent charts, as the shipped one, gets
+			// its certificate from sentry and manages the CA bundle of the webhook configuration
+			// itself, so the service CA does not apply and the resources stay install-only.
 			//
 			// Related info:
 			// - https://docs.openshift.com/container-platform/4.13/security/certificates/service-serving-certificate.html
@@ -0,0 +1,175 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewApprovalAction(l logr.Logger) Action {
+	return &ApprovalAction{
+		l:       l.WithName("action").WithName("approval"),
+		planner: newPlanner(),
+	}
+}
+
+// ApprovalAction gates the changes to the control plane when the approval policy is Manual.
+//
+// When the spec or the chart changes, the action computes the plan of objects to create,
+// update and prune, stores it in a ConfigMap named after the resource and holds the
+// reconciliation until the resource is annotated with the hash of the plan:
+//
+// - operator.dapr.io/approved-plan: ${hash}
+//
+// The plan is re-computed at every reconciliation, if it changes, i.e. because the live
+// objects have been changed, a new approval is required.
+//
+// The action MUST be executed before any action that changes the live release.
+type ApprovalAction struct {
+	l       logr.Logger
+	planner *planner
+}
+
+func (a *ApprovalAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *ApprovalAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if rc.Resource.Spec.ApprovalPolicy != v1beta1.ApprovalPolicyManual {
+		rc.Resource.Status.Plan = nil
+
+		return nil
+	}
+
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	// changes are only gated when the spec or the chart change, the reconciliation
+	// of the live objects out of an approved generation is performed as usual
+	if rc.Resource.Generation == rc.Resource.Status.ObservedGeneration && !rc.ChartChanged(c) {
+		return nil
+	}
+
+	p, err := a.planner.Compute(ctx, rc)
+	if err != nil {
+		return fmt.Errorf("cannot compute plan: %w", err)
+	}
+
+	hash, err := p.Hash()
+	if err != nil {
+		return fmt.Errorf("cannot compute plan hash: %w", err)
+	}
+
+	status := v1beta1.PlanStatus{
+		Hash:      hash,
+		ConfigMap: planConfigMapName(rc),
+		Create:    p.Count(ChangeCreate),
+		Update:    p.Count(ChangeUpdate),
+		Prune:     p.Count(ChangePrune),
+		Approved:  rc.Resource.GetAnnotations()[DaprInstanceApprovedPlanAnnotation] == hash,
+	}
+
+	if rc.Resource.Status.Plan == nil || rc.Resource.Status.Plan.Hash != hash {
+		if err := a.store(ctx, rc, p, hash); err != nil {
+			return err
+		}
+
+		rc.Reconciler.Event(
+			rc.Resource,
+			corev1.EventTypeNormal,
+			"PlanComputed",
+			fmt.Sprintf("Plan %s computed (create: %d, update: %d, prune: %d), details in ConfigMap %s",
+				hash,
+				status.Create,
+				status.Update,
+				status.Prune,
+				status.ConfigMap),
+		)
+	}
+
+	rc.Resource.Status.Plan = &status
+
+	condition := metav1.Condition{
+		Type:               conditions.TypePending,
+		Status:             metav1.ConditionFalse,
+		Reason:             conditions.ReasonPlanApproved,
+		Message:            "plan " + hash + " approved",
+		ObservedGeneration: rc.Resource.Generation,
+	}
+
+	if !status.Approved {
+		message := fmt.Sprintf("waiting for plan %s to be approved with the %s annotation",
+			hash,
+			DaprInstanceApprovedPlanAnnotation)
+
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = conditions.ReasonAwaitingApproval
+		condition.Message = message
+
+		rc.Hold(conditions.ReasonAwaitingApproval, message)
+	}
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+	return nil
+}
+
+func (a *ApprovalAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
+
+func (a *ApprovalAction) store(ctx context.Context, rc *ReconciliationRequest, p *plan, hash string) error {
+	data, err := p.Data()
+	if err != nil {
+		return err
+	}
+
+	ref := resources.OwnerReference(rc.Resource)
+
+	cm := corev1ac.ConfigMap(planConfigMapName(rc), rc.Resource.Namespace).
+		WithAnnotations(map[string]string{
+			DaprInstanceApprovedPlanAnnotation: hash,
+		}).
+		WithOwnerReferences(metav1ac.OwnerReference().
+			WithAPIVersion(ref.APIVersion).
+			WithKind(ref.Kind).
+			WithName(ref.Name).
+			WithUID(ref.UID).
+			WithBlockOwnerDeletion(true).
+			WithController(true)).
+		WithData(data)
+
+	_, err = rc.Client.CoreV1().ConfigMaps(rc.Resource.Namespace).Apply(ctx, cm, metav1.ApplyOptions{
+		FieldManager: controller.FieldManager,
+		Force:        true,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot store plan in ConfigMap %s: %w", planConfigMapName(rc), err)
+	}
+
+	return nil
+}
+
+func planConfigMapName(rc *ReconciliationRequest) string {
+	return rc.Resource.Name + "-plan"
+}
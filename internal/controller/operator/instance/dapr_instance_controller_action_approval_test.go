@@ -0,0 +1,122 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestApprovalAction(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newClient()
+	recorder := record.NewFakeRecorder(10)
+	action := instance.NewApprovalAction(logr.Discard())
+
+	res := daprInstance(1, `{"dapr_operator":{"logLevel":"debug"}}`)
+	res.Spec.ApprovalPolicy = daprApi.ApprovalPolicyManual
+
+	run := func() *instance.ReconciliationRequest {
+		rr, err := instance.NewReconciliationRequest(c, helm.Options{ChartsDir: chartsDir}, res)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		rr.Reconciler = instance.NewTestReconciler(c, recorder)
+
+		g.Expect(action.Run(t.Context(), &rr)).To(Succeed())
+
+		return &rr
+	}
+
+	// the plan is computed, stored and awaits approval
+	rr := run()
+	g.Expect(rr.Held()).To(BeTrue())
+	g.Expect(res.Status.Plan).ToNot(BeNil())
+	g.Expect(res.Status.Plan.Approved).To(BeFalse())
+	g.Expect(res.Status.Plan.Create).ToNot(BeZero())
+	g.Expect(res.Status.Plan.ConfigMap).To(Equal(res.Name + "-plan"))
+	g.Expect(pendingReason(res)).To(Equal(conditions.ReasonAwaitingApproval))
+	g.Expect(<-recorder.Events).To(HavePrefix("Normal PlanComputed"))
+
+	hash := res.Status.Plan.Hash
+	g.Expect(planConfigMap(t, c, res)).To(HaveKeyWithValue(instance.DaprInstanceApprovedPlanAnnotation, hash))
+
+	// the same plan is not stored again
+	rr = run()
+	g.Expect(rr.Held()).To(BeTrue())
+	g.Expect(res.Status.Plan.Hash).To(Equal(hash))
+	g.Expect(recorder.Events).To(BeEmpty())
+
+	// an approval of a different plan does not match
+	res.SetAnnotations(map[string]string{instance.DaprInstanceApprovedPlanAnnotation: "unknown"})
+
+	rr = run()
+	g.Expect(rr.Held()).To(BeTrue())
+	g.Expect(res.Status.Plan.Approved).To(BeFalse())
+
+	// the plan is approved
+	res.SetAnnotations(map[string]string{instance.DaprInstanceApprovedPlanAnnotation: hash})
+
+	rr = run()
+	g.Expect(rr.Held()).To(BeFalse())
+	g.Expect(res.Status.Plan.Approved).To(BeTrue())
+	g.Expect(pendingReason(res)).To(Equal(conditions.ReasonPlanApproved))
+
+	// a change to the spec invalidates the approval
+	res.Generation = 2
+	res.Spec.Values = &daprApi.JSON{RawMessage: daprApi.RawMessage(`{"dapr_operator":{"logLevel":"info"}}`)}
+
+	rr = run()
+	g.Expect(rr.Held()).To(BeTrue())
+	g.Expect(res.Status.Plan.Hash).ToNot(Equal(hash))
+	g.Expect(res.Status.Plan.Approved).To(BeFalse())
+	g.Expect(pendingReason(res)).To(Equal(conditions.ReasonAwaitingApproval))
+	g.Expect(<-recorder.Events).To(HavePrefix("Normal PlanComputed"))
+	g.Expect(planConfigMap(t, c, res)).To(HaveKeyWithValue(instance.DaprInstanceApprovedPlanAnnotation, res.Status.Plan.Hash))
+}
+
+func TestApprovalActionAutomatic(t *testing.T) {
+	g := NewWithT(t)
+
+	res := daprInstance(1, "")
+	res.Spec.ApprovalPolicy = daprApi.ApprovalPolicyAutomatic
+	res.Status.Plan = &daprApi.PlanStatus{Hash: "stale"}
+
+	rr, err := instance.NewReconciliationRequest(newClient(), helm.Options{ChartsDir: chartsDir}, res)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(instance.NewApprovalAction(logr.Discard()).Run(t.Context(), &rr)).To(Succeed())
+	g.Expect(rr.Held()).To(BeFalse())
+	g.Expect(res.Status.Plan).To(BeNil())
+}
+
+func pendingReason(res *daprApi.DaprInstance) string {
+	c := meta.FindStatusCondition(res.Status.Conditions, conditions.TypePending)
+	if c == nil {
+		return ""
+	}
+
+	return c.Reason
+}
+
+// planConfigMap returns the annotations of the ConfigMap the plan is stored in.
+func planConfigMap(t *testing.T, c *client.Client, res *daprApi.DaprInstance) map[string]string {
+	t.Helper()
+
+	cm, err := c.CoreV1().ConfigMaps(res.Namespace).Get(t.Context(), res.Status.Plan.ConfigMap, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("cannot get plan ConfigMap: %v", err)
+	}
+
+	return cm.Annotations
+}
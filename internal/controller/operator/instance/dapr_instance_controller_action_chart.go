@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	"github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
 	"github.com/go-logr/logr"
 	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
 
@@ -40,17 +41,31 @@ func (a *ChartAction) Run(ctx context.Context, rc *ReconciliationRequest) error
 	}
 
 	if rc.Resource.Status.Chart == nil {
-		rc.Resource.Status.Chart = &v1alpha1.ChartMeta{}
+		rc.Resource.Status.Chart = &v1beta1.ChartMeta{}
 	}
 
 	rc.Resource.Status.Chart.Repo = ChartRepoEmbedded
 	rc.Resource.Status.Chart.Version = c.Version()
 	rc.Resource.Status.Chart.Name = c.Name()
+	rc.Resource.Status.Chart.Constraint = rc.Helm.chartConstraint
+	rc.Resource.Status.Chart.ResolvedAt = rc.Helm.chartResolvedAt
 
 	if rc.Resource.Spec.Chart != nil {
 		rc.Resource.Status.Chart.Repo = rc.Resource.Spec.Chart.Repo
 	}
 
+	if rc.Helm.chartConstraint != "" && rc.InstalledChart != nil && rc.InstalledChart.Version != c.Version() {
+		rc.Reconciler.Event(
+			rc.Resource,
+			corev1.EventTypeNormal,
+			"ChartVersionResolved",
+			fmt.Sprintf("Chart version constraint %s resolved to %s (installed: %s)",
+				rc.Helm.chartConstraint,
+				c.Version(),
+				rc.InstalledChart.Version),
+		)
+	}
+
 	return nil
 }
 
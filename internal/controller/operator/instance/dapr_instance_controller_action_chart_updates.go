@@ -0,0 +1,166 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewChartUpdatesAction(l logr.Logger) Action {
+	return &ChartUpdatesAction{
+		l: l.WithName("action").WithName("chart-updates"),
+	}
+}
+
+// ChartUpdatesAction periodically queries the chart repository for versions newer than
+// the installed one and reports them in the resource status, without applying them.
+//
+// The versions are cached and the repository is queried again only once the resolve
+// interval has elapsed or the chart spec has changed. An event is emitted the first
+// time a new version is seen.
+type ChartUpdatesAction struct {
+	l        logr.Logger
+	key      string
+	checked  time.Time
+	versions []*semver.Version
+}
+
+func (a *ChartUpdatesAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *ChartUpdatesAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	spec := rc.Resource.Spec.Chart
+
+	// the embedded chart cannot be updated
+	if spec == nil || spec.Repo == ChartRepoEmbedded || !helm.IsRemoteChart(spec.Repo, spec.Name) {
+		rc.Resource.Status.AvailableUpdates = nil
+
+		meta.RemoveStatusCondition(&rc.Resource.Status.Conditions, conditions.TypeChartUpdatesChecked)
+
+		return nil
+	}
+
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	interval := helm.DefaultResolveInterval
+	if spec.ResolveInterval != nil && spec.ResolveInterval.Duration > 0 {
+		interval = spec.ResolveInterval.Duration
+	}
+
+	key := spec.Repo + "/" + spec.Name
+
+	if a.key != key || time.Since(a.checked) >= interval {
+		ro, err := rc.repositoryOptions(ctx)
+		if err != nil {
+			return err
+		}
+
+		versions, err := helm.ChartVersions(spec.Repo, spec.Name, ro)
+		if err != nil {
+			// failing to check for updates must not prevent the reconciliation of the
+			// resource, the previously reported updates are kept as they are
+			a.l.Error(err, "cannot list chart versions", "repo", spec.Repo, "name", spec.Name)
+
+			// the event is only emitted when the check starts failing, not at each
+			// reconciliation while the repository is unavailable
+			if !meta.IsStatusConditionFalse(rc.Resource.Status.Conditions, conditions.TypeChartUpdatesChecked) {
+				rc.Reconciler.Event(
+					rc.Resource,
+					corev1.EventTypeWarning,
+					"ChartUpdatesCheckFailed",
+					fmt.Sprintf("Cannot check for chart updates: %s", err.Error()),
+				)
+			}
+
+			meta.SetStatusCondition(&rc.Resource.Status.Conditions, metav1.Condition{
+				Type:               conditions.TypeChartUpdatesChecked,
+				Status:             metav1.ConditionFalse,
+				Reason:             conditions.ReasonRepositoryUnavailable,
+				Message:            err.Error(),
+				ObservedGeneration: rc.Resource.Generation,
+			})
+
+			return nil
+		}
+
+		a.key = key
+		a.checked = time.Now()
+		a.versions = versions
+
+		meta.SetStatusCondition(&rc.Resource.Status.Conditions, metav1.Condition{
+			Type:               conditions.TypeChartUpdatesChecked,
+			Status:             metav1.ConditionTrue,
+			Reason:             conditions.ReasonChartUpdatesChecked,
+			Message:            fmt.Sprintf("%d versions found", len(versions)),
+			ObservedGeneration: rc.Resource.Generation,
+		})
+	}
+
+	rc.RequeueAfter(interval - time.Since(a.checked))
+
+	newer, err := helm.NewerVersions(a.versions, c.Version())
+	if err != nil {
+		// as for the listing, a chart version that cannot be compared, i.e. not semver
+		// compliant, must not prevent the reconciliation of the resource
+		a.l.Error(err, "cannot compare chart versions", "repo", spec.Repo, "name", spec.Name, "version", c.Version())
+
+		return nil
+	}
+
+	current, err := semver.NewVersion(c.Version())
+	if err != nil {
+		a.l.Error(err, "cannot compare chart versions", "repo", spec.Repo, "name", spec.Name, "version", c.Version())
+
+		return nil
+	}
+
+	updates := make([]v1beta1.ChartUpdate, 0, len(newer))
+
+	for _, v := range newer {
+		u := v1beta1.ChartUpdate{
+			Version: v.Original(),
+			Major:   v.Major() > current.Major(),
+			Minor:   v.Major() == current.Major() && v.Minor() > current.Minor(),
+			Patch:   v.Major() == current.Major() && v.Minor() == current.Minor(),
+		}
+
+		seen := slices.ContainsFunc(rc.Resource.Status.AvailableUpdates, func(in v1beta1.ChartUpdate) bool {
+			return in.Version == u.Version
+		})
+
+		if !seen {
+			rc.Reconciler.Event(
+				rc.Resource,
+				corev1.EventTypeNormal,
+				"ChartUpdateAvailable",
+				fmt.Sprintf("Chart %s version %s is available (installed: %s)", c.Name(), u.Version, c.Version()),
+			)
+		}
+
+		updates = append(updates, u)
+	}
+
+	rc.Resource.Status.AvailableUpdates = updates
+
+	return nil
+}
+
+func (a *ChartUpdatesAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
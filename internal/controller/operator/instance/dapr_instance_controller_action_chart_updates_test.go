@@ -0,0 +1,70 @@
+package instance_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestChartUpdatesEventsOnConditionChanges(t *testing.T) {
+	g := NewWithT(t)
+
+	available, _ := chartRepository(t)
+
+	unavailable := httptest.NewServer(http.NotFoundHandler())
+	unavailable.Close()
+
+	recorder := record.NewFakeRecorder(10)
+	action := instance.NewChartUpdatesAction(logr.Discard())
+
+	res := &daprApi.DaprInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+	}
+
+	run := func(repo string) *metav1.Condition {
+		res.Spec.Chart = &daprApi.ChartSpec{Repo: repo, Name: "dapr", Version: "1.16.0"}
+
+		rr := &instance.ReconciliationRequest{
+			Reconciler: instance.NewTestReconciler(nil, recorder),
+			Resource:   res,
+		}
+
+		g.Expect(instance.LoadChart(t.Context(), rr, chartsDir)).To(Succeed())
+		g.Expect(action.Run(t.Context(), rr)).To(Succeed())
+
+		return meta.FindStatusCondition(res.Status.Conditions, conditions.TypeChartUpdatesChecked)
+	}
+
+	// the warning is emitted once while the repository stays unavailable
+	for range 3 {
+		c := run(unavailable.URL)
+		g.Expect(c).ToNot(BeNil())
+		g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(c.Reason).To(Equal(conditions.ReasonRepositoryUnavailable))
+	}
+
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(HavePrefix("Warning ChartUpdatesCheckFailed"))
+
+	c := run(available.URL)
+	g.Expect(c).ToNot(BeNil())
+	g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(recorder.Events).To(BeEmpty())
+
+	// and again once the repository becomes unavailable again
+	c = run(unavailable.URL)
+	g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(HavePrefix("Warning ChartUpdatesCheckFailed"))
+}
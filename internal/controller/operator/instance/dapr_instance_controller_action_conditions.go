@@ -32,6 +32,12 @@ func (a *ConditionsAction) Configure(_ context.Context, _ *client.Client, b *bui
 }
 
 func (a *ConditionsAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
 	crs, err := currentReleaseSelector(ctx, rc)
 	if err != nil {
 		return fmt.Errorf("cannot compute current release selector: %w", err)
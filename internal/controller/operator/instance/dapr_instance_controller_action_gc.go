@@ -7,6 +7,8 @@ import (
 
 	"github.com/dapr/kubernetes-operator/pkg/controller/gc"
 	"github.com/dapr/kubernetes-operator/pkg/helm"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
@@ -46,6 +48,12 @@ func (a *GCAction) Configure(_ context.Context, _ *client.Client, b *builder.Bui
 }
 
 func (a *GCAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
 	c, err := rc.Chart(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot load chart: %w", err)
@@ -57,30 +65,37 @@ func (a *GCAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
 	}
 
 	deleted, err := a.gc.Run(ctx, rc.Client, rc.Resource.Namespace, s, func(ctx context.Context, obj unstructured.Unstructured) (bool, error) {
-		if obj.GetLabels() == nil {
-			return false, nil
-		}
+		return obsolete(rc, c.Version(), obj.GetLabels())
+	})
+	if err != nil {
+		return fmt.Errorf("cannot run gc: %w", err)
+	}
 
-		gen := obj.GetLabels()[helm.ReleaseGeneration]
-		ver := obj.GetLabels()[helm.ReleaseVersion]
+	// the gc only collects namespaced resources, the PriorityClass is the only cluster scoped
+	// resource that can be renamed or removed through the spec
+	pcs, err := rc.Client.SchedulingV1().PriorityClasses().List(ctx, metav1.ListOptions{
+		LabelSelector: s.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot list priority classes: %w", err)
+	}
 
-		if gen == "" || ver == "" {
-			return false, nil
+	for i := range pcs.Items {
+		ok, err := obsolete(rc, c.Version(), pcs.Items[i].Labels)
+		if err != nil {
+			return fmt.Errorf("cannot run gc: %w", err)
 		}
 
-		if ver != c.Version() {
-			return true, nil
+		if !ok {
+			continue
 		}
 
-		g, err := strconv.Atoi(gen)
-		if err != nil {
-			return false, fmt.Errorf("cannot determine release generation: %w", err)
+		err = rc.Client.SchedulingV1().PriorityClasses().Delete(ctx, pcs.Items[i].Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("cannot delete priority class %s: %w", pcs.Items[i].Name, err)
 		}
 
-		return rc.Resource.Generation > int64(g), nil
-	})
-	if err != nil {
-		return fmt.Errorf("cannot run gc: %w", err)
+		deleted++
 	}
 
 	a.l.Info("gc", "deleted", deleted)
@@ -91,3 +106,25 @@ func (a *GCAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
 func (a *GCAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
 	return nil
 }
+
+// obsolete returns true if the given release labels denote a resource rendered out of a
+// release version different from the given one, or out of an older generation of the CR.
+func obsolete(rc *ReconciliationRequest, version string, l map[string]string) (bool, error) {
+	gen := l[helm.ReleaseGeneration]
+	ver := l[helm.ReleaseVersion]
+
+	if gen == "" || ver == "" {
+		return false, nil
+	}
+
+	if ver != version {
+		return true, nil
+	}
+
+	g, err := strconv.Atoi(gen)
+	if err != nil {
+		return false, fmt.Errorf("cannot determine release generation: %w", err)
+	}
+
+	return rc.Resource.Generation > int64(g), nil
+}
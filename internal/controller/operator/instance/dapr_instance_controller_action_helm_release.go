@@ -0,0 +1,219 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	helmtime "helm.sh/helm/v3/pkg/time"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	helmReleaseDescription = "Managed by the Dapr Kubernetes Operator, do not upgrade or uninstall with Helm"
+)
+
+func NewHelmReleaseAction(l logr.Logger) Action {
+	return &HelmReleaseAction{
+		l: l.WithName("action").WithName("helm-release"),
+	}
+}
+
+// HelmReleaseAction maintains Helm release records, stored as sh.helm.release.v1.* Secrets
+// as done by the Helm storage driver, reflecting the chart, the values and the manifest of
+// the release, so that the control plane can be inspected with Helm based tools, such as:
+//
+// - helm list
+// - helm get values
+//
+// The records are labelled with app.kubernetes.io/managed-by to mark them as owned by the
+// operator, records of a release not managed by the operator are never modified. If any of
+// the previous actions failed, i.e. the resources could not be applied, the release is
+// recorded as failed.
+type HelmReleaseAction struct {
+	l logr.Logger
+}
+
+func (a *HelmReleaseAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *HelmReleaseAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	s := helm.ReleaseStorage(rc.Client.CoreV1().Secrets(rc.Resource.Namespace))
+
+	last, err := s.Last(rc.Resource.Name)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return fmt.Errorf("cannot get helm release %s: %w", rc.Resource.Name, err)
+	}
+
+	if last != nil && last.Labels[helm.ReleaseManagedBy] != controller.FieldManager {
+		a.l.Info("run", "skip", "true", "reason", "helm release not managed by the operator")
+
+		return nil
+	}
+
+	status := release.StatusDeployed
+
+	switch {
+	case rc.Failed():
+		status = release.StatusFailed
+	case meta.IsStatusConditionTrue(rc.Resource.Status.Conditions, conditions.TypeProgressing):
+		status = release.StatusPendingUpgrade
+	}
+
+	generation := strconv.FormatInt(rc.Resource.Generation, 10)
+
+	// same release, only the status may have changed
+	if last != nil && last.Labels[helm.ReleaseGeneration] == generation && last.Chart.Metadata.Version == c.Version() {
+		if last.Info.Status == status {
+			return nil
+		}
+
+		last.SetStatus(status, helmReleaseDescription)
+		last.Info.LastDeployed = helmtime.Now()
+
+		if err := s.Update(last); err != nil {
+			return fmt.Errorf("cannot update helm release %s: %w", rc.Resource.Name, err)
+		}
+
+		return nil
+	}
+
+	rls, err := a.release(ctx, rc, last, status)
+	if err != nil {
+		return err
+	}
+
+	if last != nil && (last.Info.Status == release.StatusDeployed || last.Info.Status == release.StatusPendingUpgrade) {
+		last.SetStatus(release.StatusSuperseded, helmReleaseDescription)
+
+		if err := s.Update(last); err != nil {
+			return fmt.Errorf("cannot update helm release %s: %w", rc.Resource.Name, err)
+		}
+	}
+
+	if err := s.Create(rls); err != nil {
+		return fmt.Errorf("cannot create helm release %s: %w", rc.Resource.Name, err)
+	}
+
+	a.l.Info("run", "release", rls.Name, "version", rls.Version, "chart", c.Version())
+
+	return nil
+}
+
+func (a *HelmReleaseAction) Cleanup(_ context.Context, rc *ReconciliationRequest) error {
+	s := helm.ReleaseStorage(rc.Client.CoreV1().Secrets(rc.Resource.Namespace))
+
+	history, err := s.History(rc.Resource.Name)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("cannot get helm release %s history: %w", rc.Resource.Name, err)
+	}
+
+	for _, r := range history {
+		if r.Labels[helm.ReleaseManagedBy] != controller.FieldManager {
+			continue
+		}
+
+		if _, err := s.Delete(r.Name, r.Version); err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+			return fmt.Errorf("cannot delete helm release %s version %d: %w", r.Name, r.Version, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *HelmReleaseAction) release(
+	ctx context.Context,
+	rc *ReconciliationRequest,
+	last *release.Release,
+	status release.Status,
+) (*release.Release, error) {
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	items, err := rc.Render(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render a chart: %w", err)
+	}
+
+	sortResources(items)
+
+	var manifest strings.Builder
+
+	for i := range items {
+		resources.Labels(&items[i], releaseLabels(rc, c.Version()))
+
+		data, err := yaml.Marshal(items[i].Object)
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal resource %s: %w", resources.Ref(&items[i]), err)
+		}
+
+		manifest.WriteString("---\n")
+		manifest.Write(data)
+	}
+
+	now := helmtime.Now()
+
+	rls := release.Release{
+		Name:      rc.Resource.Name,
+		Namespace: rc.Resource.Namespace,
+		Version:   1,
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{
+				APIVersion: chart.APIVersionV2,
+				Name:       c.Name(),
+				Version:    c.Version(),
+			},
+		},
+		Config:   rc.Helm.ChartValues,
+		Manifest: manifest.String(),
+		Info: &release.Info{
+			FirstDeployed: now,
+			LastDeployed:  now,
+			Status:        status,
+			Description:   helmReleaseDescription,
+		},
+		Labels: map[string]string{
+			helm.ReleaseManagedBy:  controller.FieldManager,
+			helm.ReleaseGeneration: strconv.FormatInt(rc.Resource.Generation, 10),
+		},
+	}
+
+	if last != nil {
+		rls.Version = last.Version + 1
+		rls.Info.FirstDeployed = last.Info.FirstDeployed
+	}
+
+	return &rls, nil
+}
@@ -0,0 +1,182 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/storage"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+type helmReleaseTest struct {
+	t      *testing.T
+	client *client.Client
+	action instance.Action
+}
+
+func (h *helmReleaseTest) run(res *daprApi.DaprInstance, fn func(rr *instance.ReconciliationRequest)) {
+	h.t.Helper()
+
+	rr, err := instance.NewReconciliationRequest(h.client, helm.Options{ChartsDir: chartsDir}, res)
+	if err != nil {
+		h.t.Fatalf("invalid request: %v", err)
+	}
+
+	if fn != nil {
+		fn(&rr)
+	}
+
+	if err := h.action.Run(h.t.Context(), &rr); err != nil {
+		h.t.Fatalf("cannot run action: %v", err)
+	}
+}
+
+func (h *helmReleaseTest) history() []*release.Release {
+	h.t.Helper()
+
+	history, err := h.storage().History(instance.DaprInstanceResourceName)
+	if err != nil {
+		h.t.Fatalf("cannot get release history: %v", err)
+	}
+
+	releaseutil.SortByRevision(history)
+
+	return history
+}
+
+func (h *helmReleaseTest) storage() *storage.Storage {
+	return helm.ReleaseStorage(h.client.CoreV1().Secrets("dapr-system"))
+}
+
+func TestHelmReleaseAction(t *testing.T) {
+	g := NewWithT(t)
+
+	h := helmReleaseTest{
+		t:      t,
+		client: newClient(),
+		action: instance.NewHelmReleaseAction(logr.Discard()),
+	}
+
+	res := daprInstance(1, `{"dapr_operator":{"logLevel":"debug"}}`)
+
+	// the first release is recorded
+	h.run(res, nil)
+
+	history := h.history()
+	g.Expect(history).To(HaveLen(1))
+	g.Expect(history[0].Version).To(Equal(1))
+	g.Expect(history[0].Info.Status).To(Equal(release.StatusDeployed))
+	g.Expect(history[0].Chart.Metadata.Name).To(Equal("dapr"))
+	g.Expect(history[0].Chart.Metadata.Version).ToNot(BeEmpty())
+	g.Expect(history[0].Config).To(HaveKeyWithValue("dapr_operator", HaveKeyWithValue("logLevel", "debug")))
+	g.Expect(history[0].Manifest).To(ContainSubstring("name: dapr-operator"))
+	g.Expect(history[0].Labels).To(And(
+		HaveKeyWithValue(helm.ReleaseManagedBy, controller.FieldManager),
+		HaveKeyWithValue(helm.ReleaseGeneration, "1"),
+	))
+
+	firstDeployed := history[0].Info.FirstDeployed
+
+	// the same generation does not bump the revision
+	h.run(res, nil)
+	g.Expect(h.history()).To(HaveLen(1))
+
+	// an upgrade in progress is reflected by the status of the same revision
+	res.Status.Conditions = []metav1.Condition{{
+		Type:   conditions.TypeProgressing,
+		Status: metav1.ConditionTrue,
+		Reason: conditions.ReasonUpgrading,
+	}}
+
+	h.run(res, nil)
+
+	history = h.history()
+	g.Expect(history).To(HaveLen(1))
+	g.Expect(history[0].Info.Status).To(Equal(release.StatusPendingUpgrade))
+
+	meta.RemoveStatusCondition(&res.Status.Conditions, conditions.TypeProgressing)
+
+	// a new generation bumps the revision and supersedes the previous one
+	res.Generation = 2
+	res.Spec.Values = &daprApi.JSON{RawMessage: daprApi.RawMessage(`{"dapr_operator":{"logLevel":"info"}}`)}
+
+	h.run(res, nil)
+
+	history = h.history()
+	g.Expect(history).To(HaveLen(2))
+	g.Expect(history[0].Info.Status).To(Equal(release.StatusSuperseded))
+	g.Expect(history[1].Version).To(Equal(2))
+	g.Expect(history[1].Info.Status).To(Equal(release.StatusDeployed))
+	g.Expect(history[1].Info.FirstDeployed).To(Equal(firstDeployed))
+	g.Expect(history[1].Labels).To(HaveKeyWithValue(helm.ReleaseGeneration, "2"))
+	g.Expect(history[1].Config).To(HaveKeyWithValue("dapr_operator", HaveKeyWithValue("logLevel", "info")))
+
+	// a release that could not be applied is recorded as failed
+	res.Generation = 3
+
+	h.run(res, instance.Fail)
+
+	history = h.history()
+	g.Expect(history).To(HaveLen(3))
+	g.Expect(history[1].Info.Status).To(Equal(release.StatusSuperseded))
+	g.Expect(history[2].Version).To(Equal(3))
+	g.Expect(history[2].Info.Status).To(Equal(release.StatusFailed))
+
+	// and as deployed once it has been applied, the failed revision is not superseded
+	res.Generation = 4
+
+	h.run(res, nil)
+
+	history = h.history()
+	g.Expect(history).To(HaveLen(4))
+	g.Expect(history[2].Info.Status).To(Equal(release.StatusFailed))
+	g.Expect(history[3].Info.Status).To(Equal(release.StatusDeployed))
+
+	// the records are deleted along with the DaprInstance
+	g.Expect(h.action.Cleanup(t.Context(), &instance.ReconciliationRequest{Client: h.client, Resource: res})).To(Succeed())
+
+	_, err := h.storage().History(instance.DaprInstanceResourceName)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestHelmReleaseActionNotManaged(t *testing.T) {
+	g := NewWithT(t)
+
+	h := helmReleaseTest{
+		t:      t,
+		client: newClient(),
+		action: instance.NewHelmReleaseAction(logr.Discard()),
+	}
+
+	// a release installed with Helm with the same name
+	g.Expect(h.storage().Create(&release.Release{
+		Name:      instance.DaprInstanceResourceName,
+		Namespace: "dapr-system",
+		Version:   1,
+		Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "dapr", Version: "1.15.0"}},
+		Info:      &release.Info{Status: release.StatusDeployed},
+	})).To(Succeed())
+
+	h.run(daprInstance(1, ""), nil)
+
+	history := h.history()
+	g.Expect(history).To(HaveLen(1))
+	g.Expect(history[0].Chart.Metadata.Version).To(Equal("1.15.0"))
+	g.Expect(history[0].Info.Status).To(Equal(release.StatusDeployed))
+
+	g.Expect(h.action.Cleanup(t.Context(), &instance.ReconciliationRequest{Client: h.client, Resource: daprInstance(1, "")})).To(Succeed())
+	g.Expect(h.history()).To(HaveLen(1))
+}
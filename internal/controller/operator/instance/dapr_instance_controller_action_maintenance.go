@@ -0,0 +1,166 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/maintenance"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewMaintenanceAction(l logr.Logger) Action {
+	return &MaintenanceAction{
+		l: l.WithName("action").WithName("maintenance"),
+	}
+}
+
+// MaintenanceAction holds the changes to the chart version or to the rendered control plane
+// workloads until one of the configured maintenance windows opens. Changes can be applied
+// outside the maintenance windows if the resource is annotated with:
+//
+// - operator.dapr.io/maintenance-override: "true"
+//
+// An upgrade that is already in progress is never interrupted. The action MUST be executed
+// before any action that changes the live release.
+type MaintenanceAction struct {
+	l logr.Logger
+}
+
+func (a *MaintenanceAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *MaintenanceAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if len(rc.Resource.Spec.MaintenanceWindows) == 0 {
+		meta.RemoveStatusCondition(&rc.Resource.Status.Conditions, conditions.TypePending)
+
+		return nil
+	}
+
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:               conditions.TypePending,
+		Status:             metav1.ConditionFalse,
+		Reason:             conditions.ReasonNoPendingChanges,
+		Message:            "no pending changes",
+		ObservedGeneration: rc.Resource.Generation,
+	}
+
+	changed, err := a.changed(ctx, rc)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+		return nil
+	}
+
+	open, next, err := maintenance.Evaluate(rc.Resource.Spec.MaintenanceWindows, time.Now())
+	if err != nil {
+		return fmt.Errorf("cannot evaluate maintenance windows: %w", err)
+	}
+
+	switch {
+	case open:
+		condition.Reason = conditions.ReasonInMaintenanceWindow
+		condition.Message = "changes applied within a maintenance window"
+	case maintenanceOverridden(rc):
+		a.l.Info("run", "maintenance", "overridden")
+
+		condition.Reason = conditions.ReasonMaintenanceOverridden
+		condition.Message = "changes applied outside of the maintenance windows"
+
+		rc.Reconciler.Event(
+			rc.Resource,
+			corev1.EventTypeWarning,
+			conditions.ReasonMaintenanceOverridden,
+			fmt.Sprintf("Maintenance windows overridden by the %s annotation", DaprInstanceMaintenanceOverrideAnnotation),
+		)
+	default:
+		message := "changes pending until the next maintenance window"
+		if !next.IsZero() {
+			message = "changes pending until the next maintenance window at " + next.UTC().Format(time.RFC3339)
+
+			rc.RequeueAfter(time.Until(next))
+		}
+
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = conditions.ReasonOutsideMaintenanceWindow
+		condition.Message = message
+
+		rc.Hold(conditions.ReasonOutsideMaintenanceWindow, message)
+	}
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+	return nil
+}
+
+func (a *MaintenanceAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
+
+// changed determines if the reconciliation would alter the live control plane, which is the
+// case when the chart changes or when the rendered workloads differ from the applied ones.
+// A fresh install and an upgrade that is already in progress are not subject to the
+// maintenance windows.
+func (a *MaintenanceAction) changed(ctx context.Context, rc *ReconciliationRequest) (bool, error) {
+	if rc.InstalledChart == nil {
+		return false, nil
+	}
+
+	if meta.IsStatusConditionTrue(rc.Resource.Status.Conditions, conditions.TypeProgressing) {
+		return false, nil
+	}
+
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return false, fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	if rc.ChartChanged(c) {
+		return true, nil
+	}
+
+	if rc.Resource.Status.WorkloadsDigest == "" {
+		return false, nil
+	}
+
+	items, err := rc.Render(ctx)
+	if err != nil {
+		return false, fmt.Errorf("cannot render a chart: %w", err)
+	}
+
+	digest, err := workloadsDigest(items)
+	if err != nil {
+		return false, fmt.Errorf("cannot compute workloads digest: %w", err)
+	}
+
+	return digest != rc.Resource.Status.WorkloadsDigest, nil
+}
+
+func maintenanceOverridden(rc *ReconciliationRequest) bool {
+	v, ok := rc.Resource.GetAnnotations()[DaprInstanceMaintenanceOverrideAnnotation]
+	if !ok {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+
+	return err == nil && b
+}
@@ -0,0 +1,81 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewPatchesAction(l logr.Logger) Action {
+	return &PatchesAction{
+		l: l.WithName("action").WithName("patches"),
+	}
+}
+
+// PatchesAction reports the outcome of the patches set in the spec, which are applied when the
+// chart is rendered. Patches that cannot be applied hold the changes to the live release,
+// patches that do not match any rendered resource are reported as a warning.
+//
+// The action MUST be executed before any action that changes the live release.
+type PatchesAction struct {
+	l logr.Logger
+}
+
+func (a *PatchesAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *PatchesAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if len(rc.Resource.Spec.Patches) == 0 {
+		meta.RemoveStatusCondition(&rc.Resource.Status.Conditions, conditions.TypePatchesApplied)
+
+		return nil
+	}
+
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:               conditions.TypePatchesApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditions.ReasonPatchesApplied,
+		Message:            fmt.Sprintf("%d patches applied", len(rc.Resource.Spec.Patches)),
+		ObservedGeneration: rc.Resource.Generation,
+	}
+
+	_, err := rc.Render(ctx)
+
+	switch {
+	case errors.Is(err, ErrInvalidPatch):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = conditions.ReasonInvalidPatches
+		condition.Message = err.Error()
+
+		rc.Hold(conditions.ReasonInvalidPatches, err.Error())
+	case err != nil:
+		return fmt.Errorf("cannot render a chart: %w", err)
+	case len(rc.Helm.unmatchedPatches) > 0:
+		a.l.Info("run", "unmatched", rc.Helm.unmatchedPatches)
+
+		condition.Reason = conditions.ReasonUnmatchedPatches
+		condition.Message = "patches matching no resource: " + describePatches(rc.Resource.Spec.Patches, rc.Helm.unmatchedPatches)
+	}
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+	return nil
+}
+
+func (a *PatchesAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
@@ -0,0 +1,76 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+
+	"github.com/dapr/kubernetes-operator/api/operator"
+	daprApiV1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+)
+
+func NewValidateConversionAction(l logr.Logger) Action {
+	return &ValidateConversionAction{
+		l: l.WithName("action").WithName("validate-conversion"),
+	}
+}
+
+// ValidateConversionAction warns when the spec sets fields that cannot be represented in
+// v1alpha1 while the conversion webhook is not enabled. Without the conversion webhook, the
+// resource is served as v1alpha1 as it is stored, and any update made through the v1alpha1
+// API prunes such fields, as they are only retained by the conversion.
+type ValidateConversionAction struct {
+	l logr.Logger
+}
+
+func (a *ValidateConversionAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *ValidateConversionAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	// reported once per generation
+	if rc.Resource.Generation == rc.Resource.Status.ObservedGeneration {
+		return nil
+	}
+
+	alpha := daprApiV1alpha1.DaprInstance{}
+	if err := alpha.ConvertFrom(rc.Resource.DeepCopy()); err != nil {
+		return fmt.Errorf("cannot convert to %s: %w", daprApiV1alpha1.GroupVersion.String(), err)
+	}
+
+	if _, ok := alpha.Annotations[daprApiV1alpha1.SpecAnnotation]; !ok {
+		return nil
+	}
+
+	crd, err := rc.Client.CustomResourceDefinitions().Get(ctx, "daprinstances."+operator.Group, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get custom resource definition: %w", err)
+	}
+
+	if crd.Spec.Conversion != nil && crd.Spec.Conversion.Strategy == apiextv1.WebhookConverter {
+		return nil
+	}
+
+	a.l.Info("run", "conversion", "none", "reason", conditions.ReasonNoConversionWebhook)
+
+	rc.Reconciler.Event(
+		rc.Resource,
+		corev1.EventTypeWarning,
+		conditions.ReasonNoConversionWebhook,
+		fmt.Sprintf("The spec sets fields that cannot be represented in %s and the conversion webhook is not enabled, "+
+			"updates made through the %s API would remove them", daprApiV1alpha1.GroupVersion.String(), daprApiV1alpha1.GroupVersion.String()),
+	)
+
+	return nil
+}
+
+func (a *ValidateConversionAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
@@ -0,0 +1,123 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/podsecurity"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	psaApi "k8s.io/pod-security-admission/api"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewValidatePodSecurityAction(l logr.Logger) Action {
+	return &ValidatePodSecurityAction{
+		l:          l.WithName("action").WithName("validate-pod-security"),
+		evaluators: make(map[psaApi.Level]*podsecurity.Evaluator),
+	}
+}
+
+// ValidatePodSecurityAction validates the pod templates of the rendered workloads against
+// the Pod Security Standards level set in the spec. The workloads are adjusted to comply with
+// the level when rendered, settings that cannot be adjusted, such as privileged containers or
+// host namespaces, make the changes to the live release to be held until they are fixed.
+// Nothing is validated when the level is not set.
+//
+// The action MUST be executed before any action that changes the live release.
+type ValidatePodSecurityAction struct {
+	l          logr.Logger
+	evaluators map[psaApi.Level]*podsecurity.Evaluator
+}
+
+func (a *ValidatePodSecurityAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *ValidatePodSecurityAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	if rc.Held() {
+		a.l.Info("run", "skip", "true", "reason", rc.hold.Reason)
+
+		return nil
+	}
+
+	level, ok := rc.PodSecurityLevel()
+	if !ok {
+		a.l.Info("run", "skip", "true", "reason", "pod security level not set")
+
+		meta.RemoveStatusCondition(&rc.Resource.Status.Conditions, conditions.TypePodSecurityCompliant)
+
+		return nil
+	}
+
+	items, err := rc.Render(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot render a chart: %w", err)
+	}
+
+	e, ok := a.evaluators[level]
+	if !ok {
+		e, err = podsecurity.NewEvaluator(level)
+		if err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+
+		a.evaluators[level] = e
+	}
+
+	violations, err := podSecurityViolations(e, items)
+	if err != nil {
+		return err
+	}
+
+	condition := metav1.Condition{
+		Type:               conditions.TypePodSecurityCompliant,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditions.ReasonPodSecurityCompliant,
+		Message:            "workloads comply with the " + string(level) + " pod security level",
+		ObservedGeneration: rc.Resource.Generation,
+	}
+
+	if len(violations) > 0 {
+		a.l.Info("run", "level", level, "violations", violations)
+
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = conditions.ReasonPodSecurityViolation
+		condition.Message = "workloads violate the " + string(level) + " pod security level: " + podsecurity.Summary(violations)
+
+		rc.Hold(conditions.ReasonPodSecurityViolation, condition.Message)
+	}
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+	return nil
+}
+
+func (a *ValidatePodSecurityAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
+
+// podSecurityViolations evaluates the pod templates of the given objects, it returns the
+// reasons why they do not comply indexed by object reference.
+func podSecurityViolations(e *podsecurity.Evaluator, items []unstructured.Unstructured) (map[string][]string, error) {
+	violations := make(map[string][]string)
+
+	for i := range items {
+		v, err := e.Evaluate(&items[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot evaluate pod security of %s: %w", resources.Ref(&items[i]), err)
+		}
+
+		if len(v) > 0 {
+			violations[items[i].GetKind()+"/"+items[i].GetName()] = v
+		}
+	}
+
+	return violations, nil
+}
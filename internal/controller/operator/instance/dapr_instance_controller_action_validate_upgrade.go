@@ -0,0 +1,103 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewValidateUpgradeAction(l logr.Logger) Action {
+	return &ValidateUpgradeAction{
+		l: l.WithName("action").WithName("validate-upgrade"),
+	}
+}
+
+// ValidateUpgradeAction validates the transition between the installed chart version and
+// the requested one. Transitions that are not supported by Dapr, such as downgrades or
+// skipping minor versions, are blocked and the live release is kept as it is, unless the
+// resource is annotated with:
+//
+// - operator.dapr.io/upgrade-override: "true"
+//
+// The action MUST be executed before any action that changes the live release.
+type ValidateUpgradeAction struct {
+	l logr.Logger
+}
+
+func (a *ValidateUpgradeAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *ValidateUpgradeAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	condition := metav1.Condition{
+		Type:               conditions.TypeUpgradeBlocked,
+		Status:             metav1.ConditionFalse,
+		Reason:             conditions.ReasonUpgradeAllowed,
+		Message:            "version " + c.Version() + " allowed",
+		ObservedGeneration: rc.Resource.Generation,
+	}
+
+	if rc.InstalledChart != nil && rc.InstalledChart.Version != "" {
+		err := helm.ValidateUpgrade(rc.InstalledChart.Version, c.Version())
+
+		switch {
+		case err == nil:
+			break
+		case !errors.Is(err, helm.ErrUnsupportedUpgrade):
+			// versions that are not semver compliant cannot be validated
+			a.l.Info("run", "upgrade", "unchecked", "reason", err.Error())
+		case upgradeOverridden(rc):
+			a.l.Info("run", "upgrade", "overridden", "reason", err.Error())
+
+			condition.Reason = conditions.ReasonUpgradeOverridden
+			condition.Message = err.Error()
+
+			rc.Reconciler.Event(
+				rc.Resource,
+				corev1.EventTypeWarning,
+				conditions.ReasonUpgradeOverridden,
+				fmt.Sprintf("Validation overridden by the %s annotation: %s", DaprInstanceUpgradeOverrideAnnotation, err.Error()),
+			)
+		default:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = conditions.ReasonUnsupportedUpgrade
+			condition.Message = err.Error()
+
+			rc.Hold(conditions.TypeUpgradeBlocked, err.Error())
+		}
+	}
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+	return nil
+}
+
+func (a *ValidateUpgradeAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
+
+func upgradeOverridden(rc *ReconciliationRequest) bool {
+	v, ok := rc.Resource.GetAnnotations()[DaprInstanceUpgradeOverrideAnnotation]
+	if !ok {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+
+	return err == nil && b
+}
@@ -0,0 +1,82 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+)
+
+func NewValidateValuesAction(l logr.Logger) Action {
+	return &ValidateValuesAction{
+		l:       l.WithName("action").WithName("validate-values"),
+		schemas: newValuesSchemas(),
+	}
+}
+
+// ValidateValuesAction validates the chart values, once transformed by the values transforms,
+// against the values.schema.json of the chart and of its subcharts, combined with a curated
+// schema of the values the operator manipulates. Values that do not comply with the schema
+// or transforms that fail hold the changes to the live release, top level keys that are
+// unknown to the chart, as misspelled subchart names, are reported as a warning as they are
+// silently ignored when rendering.
+//
+// The action MUST be executed before any action that changes the live release.
+type ValidateValuesAction struct {
+	l       logr.Logger
+	schemas *valuesSchemas
+}
+
+func (a *ValidateValuesAction) Configure(_ context.Context, _ *client.Client, b *builder.Builder) (*builder.Builder, error) {
+	return b, nil
+}
+
+func (a *ValidateValuesAction) Run(ctx context.Context, rc *ReconciliationRequest) error {
+	condition := metav1.Condition{
+		Type:               conditions.TypeValuesValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditions.ReasonValidValues,
+		Message:            "values valid",
+		ObservedGeneration: rc.Resource.Generation,
+	}
+
+	unknown, err := a.schemas.Validate(ctx, rc)
+
+	switch {
+	case errors.Is(err, helm.ErrInvalidValues):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = conditions.ReasonInvalidValues
+		condition.Message = err.Error()
+
+		rc.Hold(conditions.ReasonInvalidValues, err.Error())
+	case errors.Is(err, ErrInvalidValuesTransform):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = conditions.ReasonInvalidValuesTransform
+		condition.Message = err.Error()
+
+		rc.Hold(conditions.ReasonInvalidValuesTransform, err.Error())
+	case err != nil:
+		return fmt.Errorf("cannot validate values: %w", err)
+	case len(unknown) > 0:
+		a.l.Info("run", "unknown", unknown)
+
+		condition.Reason = conditions.ReasonUnknownValues
+		condition.Message = "unknown values ignored by the chart: " + strings.Join(unknown, ", ")
+	}
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, condition)
+
+	return nil
+}
+
+func (a *ValidateValuesAction) Cleanup(_ context.Context, _ *ReconciliationRequest) error {
+	return nil
+}
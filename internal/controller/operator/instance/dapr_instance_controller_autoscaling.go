@@ -0,0 +1,238 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	DeploymentOperator = "dapr-operator"
+
+	// ReplicasHandoverFieldManager is the field manager the ownership of the replicas of an
+	// autoscaled Deployment is handed over to, so they are retained when the controller
+	// stops applying them and until the HorizontalPodAutoscaler changes them.
+	ReplicasHandoverFieldManager = controller.FieldManager + "-handover"
+)
+
+// autoscaledDeployments returns the autoscaling settings set in the given spec, keyed by the
+// name of the Deployment they apply to.
+func autoscaledDeployments(s *daprApi.AutoscalingSpec) map[string]*daprApi.ComponentAutoscalingSpec {
+	answer := make(map[string]*daprApi.ComponentAutoscalingSpec)
+
+	if s == nil {
+		return answer
+	}
+
+	if s.Operator != nil {
+		answer[DeploymentOperator] = s.Operator
+	}
+
+	if s.SidecarInjector != nil {
+		answer[DeploymentSidecarInjector] = s.SidecarInjector
+	}
+
+	return answer
+}
+
+func autoscaled(rc *ReconciliationRequest, obj *unstructured.Unstructured) bool {
+	if obj.GroupVersionKind().Group != "apps" || obj.GetKind() != "Deployment" {
+		return false
+	}
+
+	_, ok := autoscaledDeployments(rc.Resource.Spec.Autoscaling)[obj.GetName()]
+
+	return ok
+}
+
+// applyAutoscaling adds a HorizontalPodAutoscaler for each of the rendered Deployments that
+// are autoscaled, and removes the replicas from such Deployments so they are left to the
+// HorizontalPodAutoscalers.
+func applyAutoscaling(s *daprApi.AutoscalingSpec, items []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	deployments := autoscaledDeployments(s)
+	if len(deployments) == 0 {
+		return items, nil
+	}
+
+	hpas := make([]unstructured.Unstructured, 0, len(deployments))
+
+	for i := range items {
+		if items[i].GroupVersionKind().Group != "apps" || items[i].GetKind() != "Deployment" {
+			continue
+		}
+
+		cs, ok := deployments[items[i].GetName()]
+		if !ok {
+			continue
+		}
+
+		unstructured.RemoveNestedField(items[i].Object, "spec", "replicas")
+
+		hpa, err := horizontalPodAutoscaler(&items[i], cs)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create HorizontalPodAutoscaler for %s: %w", resources.Ref(&items[i]), err)
+		}
+
+		hpas = append(hpas, hpa)
+	}
+
+	return append(items, hpas...), nil
+}
+
+func horizontalPodAutoscaler(d *unstructured.Unstructured, s *daprApi.ComponentAutoscalingSpec) (unstructured.Unstructured, error) {
+	hpa := autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+			Kind:       "HorizontalPodAutoscaler",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.GetName(),
+			Namespace: d.GetNamespace(),
+			Labels:    d.GetLabels(),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: d.GetAPIVersion(),
+				Kind:       d.GetKind(),
+				Name:       d.GetName(),
+			},
+			MinReplicas: s.MinReplicas,
+			MaxReplicas: s.MaxReplicas,
+		},
+	}
+
+	for _, t := range utilizationTargets(s) {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: t.name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: t.target,
+				},
+			},
+		})
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&hpa)
+	if err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("cannot convert to unstructured: %w", err)
+	}
+
+	answer := unstructured.Unstructured{Object: u}
+
+	// not meaningful for an object to be applied
+	unstructured.RemoveNestedField(answer.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(answer.Object, "status")
+
+	return answer, nil
+}
+
+type utilizationTarget struct {
+	name   corev1.ResourceName
+	target *int32
+}
+
+// utilizationTargets returns the resource utilization targets that are set in the given
+// autoscaling settings.
+func utilizationTargets(s *daprApi.ComponentAutoscalingSpec) []utilizationTarget {
+	answer := make([]utilizationTarget, 0, 2)
+
+	if s.TargetCPUUtilizationPercentage != nil {
+		answer = append(answer, utilizationTarget{name: corev1.ResourceCPU, target: s.TargetCPUUtilizationPercentage})
+	}
+
+	if s.TargetMemoryUtilizationPercentage != nil {
+		answer = append(answer, utilizationTarget{name: corev1.ResourceMemory, target: s.TargetMemoryUtilizationPercentage})
+	}
+
+	return answer
+}
+
+// handoverReplicas hands the ownership of the replicas of the given autoscaled Deployment
+// over, if they are still owned by the controller. Otherwise, as the controller stops
+// applying them, they would be removed and defaulted to one until the HorizontalPodAutoscaler
+// scales the Deployment again.
+//
+// Related info:
+// - https://kubernetes.io/docs/reference/using-api/server-side-apply/#transferring-ownership
+func handoverReplicas(ctx context.Context, rc *ReconciliationRequest, obj *unstructured.Unstructured) error {
+	dc, err := rc.Client.Dynamic(rc.Resource.Namespace, obj)
+	if err != nil {
+		return fmt.Errorf("cannot create dynamic client: %w", err)
+	}
+
+	live, err := dc.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("cannot get object %s: %w", resources.Ref(obj), err)
+	}
+
+	owned, err := ownsReplicas(live, controller.FieldManager)
+	if err != nil || !owned {
+		return err
+	}
+
+	replicas, ok, err := unstructured.NestedInt64(live.Object, "spec", "replicas")
+	if err != nil || !ok {
+		//nolint:wrapcheck
+		return err
+	}
+
+	handover := unstructured.Unstructured{}
+	handover.SetAPIVersion(obj.GetAPIVersion())
+	handover.SetKind(obj.GetKind())
+	handover.SetName(obj.GetName())
+	handover.SetNamespace(rc.Resource.Namespace)
+
+	if err := unstructured.SetNestedField(handover.Object, replicas, "spec", "replicas"); err != nil {
+		//nolint:wrapcheck
+		return err
+	}
+
+	_, err = dc.Apply(ctx, obj.GetName(), &handover, metav1.ApplyOptions{
+		FieldManager: ReplicasHandoverFieldManager,
+	})
+
+	// a conflict means the HorizontalPodAutoscaler already owns the replicas
+	if err != nil && !k8serrors.IsConflict(err) {
+		return fmt.Errorf("cannot hand over the replicas of %s: %w", resources.Ref(obj), err)
+	}
+
+	return nil
+}
+
+// ownsReplicas returns true if the given field manager has applied the replicas of the given
+// object.
+func ownsReplicas(obj *unstructured.Unstructured, manager string) (bool, error) {
+	for _, mf := range obj.GetManagedFields() {
+		if mf.Manager != manager || mf.Operation != metav1.ManagedFieldsOperationApply || mf.FieldsV1 == nil {
+			continue
+		}
+
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
+			return false, fmt.Errorf("cannot decode managed fields: %w", err)
+		}
+
+		if _, ok, _ := unstructured.NestedFieldNoCopy(fields, "f:spec", "f:replicas"); ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,189 @@
+package instance_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/pointer"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestApplyAutoscaling(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *daprApi.AutoscalingSpec
+		autoscaled  []string
+		metrics     []corev1.ResourceName
+	}{
+		{
+			name:        "none",
+			autoscaling: nil,
+			autoscaled:  []string{},
+		},
+		{
+			name:        "no component",
+			autoscaling: &daprApi.AutoscalingSpec{},
+			autoscaled:  []string{},
+		},
+		{
+			name: "default target",
+			autoscaling: &daprApi.AutoscalingSpec{
+				Operator: &daprApi.ComponentAutoscalingSpec{
+					MinReplicas: pointer.Any(int32(2)),
+					MaxReplicas: 5,
+				},
+			},
+			autoscaled: []string{"dapr-operator"},
+			metrics:    nil,
+		},
+		{
+			name: "utilization targets",
+			autoscaling: &daprApi.AutoscalingSpec{
+				Operator: &daprApi.ComponentAutoscalingSpec{
+					MinReplicas:                       pointer.Any(int32(2)),
+					MaxReplicas:                       5,
+					TargetCPUUtilizationPercentage:    pointer.Any(int32(70)),
+					TargetMemoryUtilizationPercentage: pointer.Any(int32(80)),
+				},
+			},
+			autoscaled: []string{"dapr-operator"},
+			metrics:    []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory},
+		},
+		{
+			name: "components not rendered",
+			autoscaling: &daprApi.AutoscalingSpec{
+				SidecarInjector: &daprApi.ComponentAutoscalingSpec{
+					MaxReplicas: 3,
+				},
+			},
+			autoscaled: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				object(t, operatorDeployment),
+				object(t, sentryDeployment),
+				object(t, sentryService),
+			}
+
+			items, err := instance.ApplyAutoscaling(tt.autoscaling, items)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(items).To(HaveLen(3 + len(tt.autoscaled)))
+
+			// the sentry is never autoscaled
+			g.Expect(replicas(g, find(items, "Deployment", "dapr-sentry"))).To(Equal(int64(1)))
+
+			for _, name := range tt.autoscaled {
+				_, found, err := unstructured.NestedInt64(find(items, "Deployment", name).Object, "spec", "replicas")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(found).To(BeFalse(), "the replicas of %s are left to the HorizontalPodAutoscaler", name)
+
+				u := find(items, "HorizontalPodAutoscaler", name)
+				g.Expect(u).ToNot(BeNil())
+				g.Expect(u.Object).ToNot(HaveKey("status"))
+				g.Expect(u.GetLabels()).To(HaveKeyWithValue("app.kubernetes.io/component", "operator"))
+
+				hpa := autoscalingv2.HorizontalPodAutoscaler{}
+				g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &hpa)).To(Succeed())
+
+				g.Expect(hpa.Spec.ScaleTargetRef).To(Equal(autoscalingv2.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       name,
+				}))
+				g.Expect(hpa.Spec.MinReplicas).To(Equal(tt.autoscaling.Operator.MinReplicas))
+				g.Expect(hpa.Spec.MaxReplicas).To(Equal(tt.autoscaling.Operator.MaxReplicas))
+
+				metrics := make([]corev1.ResourceName, 0, len(hpa.Spec.Metrics))
+				for _, m := range hpa.Spec.Metrics {
+					g.Expect(m.Type).To(Equal(autoscalingv2.ResourceMetricSourceType))
+					g.Expect(m.Resource.Target.Type).To(Equal(autoscalingv2.UtilizationMetricType))
+
+					metrics = append(metrics, m.Resource.Name)
+				}
+
+				if tt.metrics == nil {
+					g.Expect(metrics).To(BeEmpty())
+				} else {
+					g.Expect(metrics).To(Equal(tt.metrics))
+				}
+			}
+
+			if len(tt.autoscaled) == 0 {
+				g.Expect(replicas(g, find(items, "Deployment", "dapr-operator"))).To(Equal(int64(1)))
+			}
+		})
+	}
+}
+
+func TestOwnsReplicas(t *testing.T) {
+	fields := func(manager string, operation metav1.ManagedFieldsOperationType, f map[string]interface{}) metav1.ManagedFieldsEntry {
+		raw, err := json.Marshal(f)
+		if err != nil {
+			t.Fatalf("invalid managed fields: %v", err)
+		}
+
+		return metav1.ManagedFieldsEntry{
+			Manager:   manager,
+			Operation: operation,
+			FieldsV1:  &metav1.FieldsV1{Raw: raw},
+		}
+	}
+
+	replicas := map[string]interface{}{"f:spec": map[string]interface{}{"f:replicas": map[string]interface{}{}}}
+	template := map[string]interface{}{"f:spec": map[string]interface{}{"f:template": map[string]interface{}{}}}
+
+	tests := []struct {
+		name     string
+		managed  []metav1.ManagedFieldsEntry
+		expected bool
+	}{
+		{
+			name:     "applied by the controller",
+			managed:  []metav1.ManagedFieldsEntry{fields(controller.FieldManager, metav1.ManagedFieldsOperationApply, replicas)},
+			expected: true,
+		},
+		{
+			name:     "not applied by the controller",
+			managed:  []metav1.ManagedFieldsEntry{fields(controller.FieldManager, metav1.ManagedFieldsOperationApply, template)},
+			expected: false,
+		},
+		{
+			name:     "handed over",
+			managed:  []metav1.ManagedFieldsEntry{fields(instance.ReplicasHandoverFieldManager, metav1.ManagedFieldsOperationApply, replicas)},
+			expected: false,
+		},
+		{
+			name:     "updated",
+			managed:  []metav1.ManagedFieldsEntry{fields(controller.FieldManager, metav1.ManagedFieldsOperationUpdate, replicas)},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := object(t, operatorDeployment)
+			obj.SetManagedFields(tt.managed)
+
+			owned, err := instance.OwnsReplicas(&obj, controller.FieldManager)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(owned).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	PodDisruptionBudgetSuffix = "-disruption-budget"
+
+	DefaultPriorityClassName  = "dapr-control-plane"
+	DefaultPriorityClassValue = 1000000
+)
+
+// applyAvailability adjusts the availability of the rendered workloads:
+//
+// - when the PodDisruptionBudgets are managed, the ones rendered by the chart are replaced
+// by a PodDisruptionBudget allowing one unavailable pod for each workload running in high
+// availability or with more than one replica
+// - when a PriorityClass is set, it is created and assigned to the workloads that are not
+// assigned one through the placement
+func applyAvailability(rr *ReconciliationRequest, items []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	if rr.PodDisruptionBudgetPolicy() == daprApi.PodDisruptionBudgetPolicyManaged {
+		items = slices.DeleteFunc(items, isPodDisruptionBudget)
+
+		ha, _, _ := unstructured.NestedBool(rr.Helm.ChartValues, "global", "ha", "enabled")
+
+		budgets := make([]unstructured.Unstructured, 0)
+
+		for i := range items {
+			if !isWorkload(items[i].GroupVersionKind()) {
+				continue
+			}
+
+			if _, replicas := workloadReplicas(rr, &items[i]); replicas == 0 || (!ha && replicas == 1) {
+				continue
+			}
+
+			pdb, err := podDisruptionBudget(&items[i])
+			if err != nil {
+				return nil, fmt.Errorf("cannot create PodDisruptionBudget for %s: %w", resources.Ref(&items[i]), err)
+			}
+
+			budgets = append(budgets, pdb)
+		}
+
+		items = append(items, budgets...)
+	}
+
+	if rr.Resource.Spec.Availability == nil || rr.Resource.Spec.Availability.PriorityClass == nil {
+		return items, nil
+	}
+
+	pc := priorityClass(rr.Resource.Spec.Availability.PriorityClass)
+
+	for i := range items {
+		if !isWorkload(items[i].GroupVersionKind()) {
+			continue
+		}
+
+		name, _, err := unstructured.NestedString(items[i].Object, "spec", "template", "spec", "priorityClassName")
+		if err != nil {
+			//nolint:wrapcheck
+			return nil, err
+		}
+
+		if name != "" {
+			continue
+		}
+
+		if err := unstructured.SetNestedField(items[i].Object, pc.GetName(), "spec", "template", "spec", "priorityClassName"); err != nil {
+			//nolint:wrapcheck
+			return nil, err
+		}
+	}
+
+	return append(items, pc), nil
+}
+
+func isPodDisruptionBudget(obj unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == "policy" && obj.GetKind() == "PodDisruptionBudget"
+}
+
+// workloadReplicas returns the minimum and the maximum replicas of the given workload, which
+// are the ones of the HorizontalPodAutoscaler if the workload is autoscaled.
+func workloadReplicas(rr *ReconciliationRequest, obj *unstructured.Unstructured) (int64, int64) {
+	replicas, ok, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if ok {
+		return replicas, replicas
+	}
+
+	if s, ok := autoscaledDeployments(rr.Resource.Spec.Autoscaling)[obj.GetName()]; ok && autoscaled(rr, obj) {
+		minReplicas := int64(1)
+		if s.MinReplicas != nil {
+			minReplicas = int64(*s.MinReplicas)
+		}
+
+		return minReplicas, int64(s.MaxReplicas)
+	}
+
+	return 1, 1
+}
+
+func podDisruptionBudget(workload *unstructured.Unstructured) (unstructured.Unstructured, error) {
+	selector, _, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil {
+		//nolint:wrapcheck
+		return unstructured.Unstructured{}, err
+	}
+
+	pdb := unstructured.Unstructured{}
+	pdb.SetAPIVersion(policyv1.SchemeGroupVersion.String())
+	pdb.SetKind("PodDisruptionBudget")
+	pdb.SetName(workload.GetName() + PodDisruptionBudgetSuffix)
+	pdb.SetNamespace(workload.GetNamespace())
+	pdb.SetLabels(workload.GetLabels())
+
+	pdb.Object["spec"] = map[string]interface{}{
+		"maxUnavailable": int64(1),
+		"selector":       selector,
+	}
+
+	return pdb, nil
+}
+
+func priorityClass(s *daprApi.PriorityClassSpec) unstructured.Unstructured {
+	name := s.Name
+	if name == "" {
+		name = DefaultPriorityClassName
+	}
+
+	value := int64(s.Value)
+	if value == 0 {
+		value = DefaultPriorityClassValue
+	}
+
+	pc := unstructured.Unstructured{}
+	pc.SetAPIVersion("scheduling.k8s.io/v1")
+	pc.SetKind("PriorityClass")
+	pc.SetName(name)
+	pc.Object["value"] = value
+
+	if s.Description != "" {
+		pc.Object["description"] = s.Description
+	}
+
+	return pc
+}
+
+func isPriorityClass(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == schedulingv1.GroupName && obj.GetKind() == "PriorityClass"
+}
+
+// replacePriorityClass deletes the existing PriorityClass with the name of the given one if
+// it has been created for the same DaprInstance with a different value, which is immutable,
+// so it can be created again with the new value. The pods already running keep the priority
+// they have been admitted with until they are re-created.
+func replacePriorityClass(ctx context.Context, rc *ReconciliationRequest, obj *unstructured.Unstructured) error {
+	value, _, err := unstructured.NestedInt64(obj.Object, "value")
+	if err != nil {
+		//nolint:wrapcheck
+		return err
+	}
+
+	live, err := rc.Client.SchedulingV1().PriorityClasses().Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("cannot get priority class %s: %w", obj.GetName(), err)
+	}
+
+	// a PriorityClass not created for this DaprInstance is never deleted, the value change is
+	// then rejected when the PriorityClass is applied
+	if live.Labels[helm.ReleaseName] != rc.Resource.Name || live.Labels[helm.ReleaseNamespace] != rc.Resource.Namespace {
+		return nil
+	}
+
+	if int64(live.Value) == value {
+		return nil
+	}
+
+	err = rc.Client.SchedulingV1().PriorityClasses().Delete(ctx, live.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &live.UID},
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete priority class %s: %w", live.Name, err)
+	}
+
+	return nil
+}
+
+// blockingDisruptionBudgets returns the rendered PodDisruptionBudgets that do not allow any
+// pod of the workloads they select to be evicted, which blocks node drains entirely, keyed
+// by the name of the PodDisruptionBudget.
+func blockingDisruptionBudgets(rr *ReconciliationRequest, items []unstructured.Unstructured) (map[string]string, error) {
+	answer := make(map[string]string)
+
+	for i := range items {
+		if !isPodDisruptionBudget(items[i]) {
+			continue
+		}
+
+		pdb := policyv1.PodDisruptionBudget{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(items[i].Object, &pdb); err != nil {
+			return nil, fmt.Errorf("cannot convert to PodDisruptionBudget: %w", err)
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the selector of %s: %w", resources.Ref(&items[i]), err)
+		}
+
+		for j := range items {
+			if !isWorkload(items[j].GroupVersionKind()) {
+				continue
+			}
+
+			podLabels, _, err := unstructured.NestedStringMap(items[j].Object, "spec", "template", "metadata", "labels")
+			if err != nil {
+				//nolint:wrapcheck
+				return nil, err
+			}
+
+			if selector.Empty() || !selector.Matches(labels.Set(podLabels)) {
+				continue
+			}
+
+			// an autoscaled workload may be scaled down to its minimum replicas, at which the
+			// PodDisruptionBudget must still allow an eviction
+			replicas, _ := workloadReplicas(rr, &items[j])
+			if replicas == 0 {
+				continue
+			}
+
+			allowed, err := allowedDisruptions(&pdb, int(replicas))
+			if err != nil {
+				return nil, fmt.Errorf("cannot compute the allowed disruptions of %s: %w", resources.Ref(&items[i]), err)
+			}
+
+			if allowed <= 0 {
+				answer[pdb.Name] = fmt.Sprintf("%s %s with %d replicas", items[j].GetKind(), items[j].GetName(), replicas)
+			}
+		}
+	}
+
+	return answer, nil
+}
+
+// allowedDisruptions returns the number of pods the given PodDisruptionBudget allows to be
+// evicted when all the given replicas are healthy, as computed by the disruption controller.
+func allowedDisruptions(pdb *policyv1.PodDisruptionBudget, replicas int) (int, error) {
+	switch {
+	case pdb.Spec.MaxUnavailable != nil:
+		v, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, replicas, true)
+		if err != nil {
+			//nolint:wrapcheck
+			return 0, err
+		}
+
+		return v, nil
+	case pdb.Spec.MinAvailable != nil:
+		v, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, replicas, true)
+		if err != nil {
+			//nolint:wrapcheck
+			return 0, err
+		}
+
+		return replicas - v, nil
+	default:
+		// no eviction is allowed
+		return 0, nil
+	}
+}
+
+// disruptionBudgetsSummary formats the given blocking PodDisruptionBudgets in a stable way.
+func disruptionBudgetsSummary(blocking map[string]string) string {
+	items := make([]string, 0, len(blocking))
+
+	for _, name := range slices.Sorted(maps.Keys(blocking)) {
+		items = append(items, name+" ("+blocking[name]+")")
+	}
+
+	return strings.Join(items, ", ")
+}
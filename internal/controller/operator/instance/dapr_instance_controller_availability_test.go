@@ -0,0 +1,405 @@
+package instance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/pointer"
+
+	. "github.com/onsi/gomega"
+)
+
+const chartDisruptionBudget = `
+apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: dapr-operator
+spec:
+  minAvailable: 1
+  selector:
+    matchLabels:
+      app: dapr-operator
+`
+
+// workload returns a Deployment with the given replicas, the replicas are left unset if
+// negative, as they are for the autoscaled Deployments.
+func workload(t *testing.T, name string, replicas int) unstructured.Unstructured {
+	t.Helper()
+
+	spec := ""
+	if replicas >= 0 {
+		spec = fmt.Sprintf("  replicas: %d\n", replicas)
+	}
+
+	return object(t, fmt.Sprintf(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %[1]s
+  labels:
+    app: %[1]s
+spec:
+%[2]s  selector:
+    matchLabels:
+      app: %[1]s
+  template:
+    metadata:
+      labels:
+        app: %[1]s
+    spec:
+      containers:
+      - name: %[1]s
+        image: daprio/dapr:1.16.1
+`, name, spec))
+}
+
+func availabilityRequest(spec daprApi.DaprInstanceSpec, values map[string]interface{}) *instance.ReconciliationRequest {
+	return &instance.ReconciliationRequest{
+		Resource: &daprApi.DaprInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+			Spec:       spec,
+		},
+		Helm: instance.Helm{
+			ChartValues: values,
+		},
+	}
+}
+
+func TestApplyAvailabilityDisruptionBudgets(t *testing.T) {
+	ha := map[string]interface{}{"global": map[string]interface{}{"ha": map[string]interface{}{"enabled": true}}}
+
+	tests := []struct {
+		name     string
+		spec     daprApi.DaprInstanceSpec
+		values   map[string]interface{}
+		operator int
+		budgets  []string
+	}{
+		{
+			name:     "single replica",
+			operator: 1,
+			budgets:  []string{"dapr-sentry-disruption-budget"},
+		},
+		{
+			name:     "high availability",
+			values:   ha,
+			operator: 1,
+			budgets:  []string{"dapr-operator-disruption-budget", "dapr-sentry-disruption-budget"},
+		},
+		{
+			name:     "no replicas",
+			values:   ha,
+			operator: 0,
+			budgets:  []string{"dapr-sentry-disruption-budget"},
+		},
+		{
+			name: "autoscaled",
+			spec: daprApi.DaprInstanceSpec{
+				Autoscaling: &daprApi.AutoscalingSpec{
+					Operator: &daprApi.ComponentAutoscalingSpec{MaxReplicas: 3},
+				},
+			},
+			operator: -1,
+			budgets:  []string{"dapr-operator-disruption-budget", "dapr-sentry-disruption-budget"},
+		},
+		{
+			name: "chart",
+			spec: daprApi.DaprInstanceSpec{
+				Availability: &daprApi.AvailabilitySpec{
+					PodDisruptionBudgets: daprApi.PodDisruptionBudgetPolicyChart,
+				},
+			},
+			values:   ha,
+			operator: 1,
+			budgets:  []string{"dapr-operator"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				workload(t, "dapr-operator", tt.operator),
+				workload(t, "dapr-sentry", 2),
+				object(t, chartDisruptionBudget),
+			}
+
+			items, err := instance.ApplyAvailability(availabilityRequest(tt.spec, tt.values), items)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			budgets := make([]string, 0)
+
+			for i := range items {
+				if !instance.IsPodDisruptionBudget(items[i]) {
+					continue
+				}
+
+				budgets = append(budgets, items[i].GetName())
+
+				if tt.spec.Availability != nil {
+					continue
+				}
+
+				app := items[i].GetLabels()["app"]
+
+				g.Expect(items[i].Object["spec"]).To(Equal(map[string]interface{}{
+					"maxUnavailable": int64(1),
+					"selector": map[string]interface{}{
+						"matchLabels": map[string]interface{}{"app": app},
+					},
+				}))
+			}
+
+			g.Expect(budgets).To(ConsistOf(tt.budgets))
+		})
+	}
+}
+
+func TestApplyAvailabilityPriorityClass(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        *daprApi.PriorityClassSpec
+		class       string
+		value       int64
+		description string
+	}{
+		{
+			name:  "defaults",
+			spec:  &daprApi.PriorityClassSpec{},
+			class: instance.DefaultPriorityClassName,
+			value: instance.DefaultPriorityClassValue,
+		},
+		{
+			name:        "custom",
+			spec:        &daprApi.PriorityClassSpec{Name: "dapr", Value: 1000, Description: "Dapr"},
+			class:       "dapr",
+			value:       1000,
+			description: "Dapr",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				workload(t, "dapr-operator", 1),
+				workload(t, "dapr-sentry", 1),
+			}
+
+			// assigned through the placement
+			g.Expect(unstructured.SetNestedField(items[1].Object, "system-cluster-critical", "spec", "template", "spec", "priorityClassName")).To(Succeed())
+
+			rr := availabilityRequest(daprApi.DaprInstanceSpec{
+				Availability: &daprApi.AvailabilitySpec{
+					PodDisruptionBudgets: daprApi.PodDisruptionBudgetPolicyChart,
+					PriorityClass:        tt.spec,
+				},
+			}, nil)
+
+			items, err := instance.ApplyAvailability(rr, items)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(podSpec(g, find(items, "Deployment", "dapr-operator"))).To(HaveKeyWithValue("priorityClassName", tt.class))
+			g.Expect(podSpec(g, find(items, "Deployment", "dapr-sentry"))).To(HaveKeyWithValue("priorityClassName", "system-cluster-critical"))
+
+			pc := find(items, "PriorityClass", tt.class)
+			g.Expect(pc).ToNot(BeNil())
+			g.Expect(instance.IsPriorityClass(pc)).To(BeTrue())
+			g.Expect(pc.Object).To(HaveKeyWithValue("value", tt.value))
+
+			if tt.description == "" {
+				g.Expect(pc.Object).ToNot(HaveKey("description"))
+			} else {
+				g.Expect(pc.Object).To(HaveKeyWithValue("description", tt.description))
+			}
+		})
+	}
+}
+
+func TestAllowedDisruptions(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxUnavailable *intstr.IntOrString
+		minAvailable   *intstr.IntOrString
+		replicas       int
+		expected       int
+		err            bool
+	}{
+		{name: "max unavailable", maxUnavailable: pointer.Any(intstr.FromInt32(1)), replicas: 1, expected: 1},
+		{name: "max unavailable percentage", maxUnavailable: pointer.Any(intstr.FromString("50%")), replicas: 3, expected: 2},
+		{name: "no max unavailable", maxUnavailable: pointer.Any(intstr.FromString("0%")), replicas: 3, expected: 0},
+		{name: "min available", minAvailable: pointer.Any(intstr.FromInt32(1)), replicas: 2, expected: 1},
+		{name: "min available single replica", minAvailable: pointer.Any(intstr.FromInt32(1)), replicas: 1, expected: 0},
+		{name: "min available percentage", minAvailable: pointer.Any(intstr.FromString("50%")), replicas: 3, expected: 1},
+		{name: "none", replicas: 3, expected: 0},
+		{name: "invalid", maxUnavailable: pointer.Any(intstr.FromString("half")), replicas: 3, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pdb := policyv1.PodDisruptionBudget{
+				Spec: policyv1.PodDisruptionBudgetSpec{
+					MaxUnavailable: tt.maxUnavailable,
+					MinAvailable:   tt.minAvailable,
+				},
+			}
+
+			allowed, err := instance.AllowedDisruptions(&pdb, tt.replicas)
+			if tt.err {
+				g.Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(allowed).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestBlockingDisruptionBudgets(t *testing.T) {
+	tests := []struct {
+		name        string
+		replicas    int
+		autoscaling *daprApi.ComponentAutoscalingSpec
+		expected    map[string]string
+	}{
+		{
+			name:     "single replica",
+			replicas: 1,
+			expected: map[string]string{"dapr-operator": "Deployment dapr-operator with 1 replicas"},
+		},
+		{
+			name:     "multiple replicas",
+			replicas: 2,
+			expected: map[string]string{},
+		},
+		{
+			name:     "no replicas",
+			replicas: 0,
+			expected: map[string]string{},
+		},
+		{
+			name:        "autoscaled down to the default minimum replicas",
+			replicas:    -1,
+			autoscaling: &daprApi.ComponentAutoscalingSpec{MaxReplicas: 3},
+			expected:    map[string]string{"dapr-operator": "Deployment dapr-operator with 1 replicas"},
+		},
+		{
+			name:        "autoscaled down to the minimum replicas",
+			replicas:    -1,
+			autoscaling: &daprApi.ComponentAutoscalingSpec{MinReplicas: pointer.Any(int32(2)), MaxReplicas: 3},
+			expected:    map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			spec := daprApi.DaprInstanceSpec{}
+			if tt.autoscaling != nil {
+				spec.Autoscaling = &daprApi.AutoscalingSpec{Operator: tt.autoscaling}
+			}
+
+			items := []unstructured.Unstructured{
+				workload(t, "dapr-operator", tt.replicas),
+				workload(t, "dapr-sentry", 1),
+				object(t, chartDisruptionBudget),
+			}
+
+			blocking, err := instance.BlockingDisruptionBudgets(availabilityRequest(spec, nil), items)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(blocking).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestReplacePriorityClass(t *testing.T) {
+	owned := map[string]string{
+		helm.ReleaseName:      "dapr-instance",
+		helm.ReleaseNamespace: "dapr-system",
+	}
+
+	tests := []struct {
+		name     string
+		live     *schedulingv1.PriorityClass
+		absent   bool
+		expected int32
+	}{
+		{
+			name:   "missing",
+			live:   nil,
+			absent: true,
+		},
+		{
+			name: "same value",
+			live: &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{Name: instance.DefaultPriorityClassName, Labels: owned},
+				Value:      instance.DefaultPriorityClassValue,
+			},
+			expected: instance.DefaultPriorityClassValue,
+		},
+		{
+			name: "value changed",
+			live: &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{Name: instance.DefaultPriorityClassName, Labels: owned},
+				Value:      1000,
+			},
+			absent: true,
+		},
+		{
+			name: "not owned",
+			live: &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{Name: instance.DefaultPriorityClassName},
+				Value:      1000,
+			},
+			expected: 1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cs := fake.NewClientset()
+			if tt.live != nil {
+				cs = fake.NewClientset(tt.live)
+			}
+
+			rr := availabilityRequest(daprApi.DaprInstanceSpec{}, nil)
+			rr.Client = &client.Client{Interface: cs}
+
+			pc := instance.NewPriorityClass(&daprApi.PriorityClassSpec{})
+
+			g.Expect(instance.ReplacePriorityClass(context.Background(), rr, &pc)).To(Succeed())
+
+			live, err := cs.SchedulingV1().PriorityClasses().Get(context.Background(), instance.DefaultPriorityClassName, metav1.GetOptions{})
+			if tt.absent {
+				g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(live.Value).To(Equal(tt.expected))
+		})
+	}
+}
@@ -1,8 +1,108 @@
 package instance
 
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+	"github.com/lburgazzoli/k8s-manifests-renderer-helm/engine/customizers/values"
+	"helm.sh/helm/v3/pkg/chart"
+
+	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
+
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+)
+
+var ErrInvalidValuesTransform = errors.New("invalid values transform")
+
 const autoPullPolicySidecarInjector = `
 if (.dapr_sidecar_injector.image | has("name")) and (.dapr_sidecar_injector | has("sidecarImagePullPolicy") | not) 
 then 
   .dapr_sidecar_injector.sidecarImagePullPolicy = "Always"
 end
 `
+
+// valuesCustomizers returns the values customizers applied to the chart values, in order:
+// the pull policy of the sidecar is set out of the values as they are given, then the values
+// are merged with the default values of the chart returned by the given function, so the
+// values transforms see the same values the chart is rendered with.
+func valuesCustomizers(
+	load func(ctx context.Context) (*chart.Chart, error),
+	expressions []string,
+) []helme.ValuesCustomizer {
+	answer := make([]helme.ValuesCustomizer, 0, len(expressions)+2)
+	answer = append(answer, values.JQ(autoPullPolicySidecarInjector))
+	answer = append(answer, coalesceValues(load))
+	answer = append(answer, valuesTransforms(expressions)...)
+
+	return answer
+}
+
+// coalesceValues returns a values customizer that merges the values with the default values
+// of the chart returned by the given function.
+func coalesceValues(load func(ctx context.Context) (*chart.Chart, error)) helme.ValuesCustomizer {
+	return func(ctx context.Context, in map[string]interface{}) (map[string]interface{}, error) {
+		c, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		//nolint:wrapcheck
+		return helm.CoalesceValues(c, in)
+	}
+}
+
+// valuesTransforms returns a values customizer for each of the given jq expressions, the
+// errors they raise refer to the index of the expression in spec.valuesTransforms.
+func valuesTransforms(expressions []string) []helme.ValuesCustomizer {
+	answer := make([]helme.ValuesCustomizer, 0, len(expressions))
+
+	for i := range expressions {
+		answer = append(answer, valuesTransform(i, expressions[i]))
+	}
+
+	return answer
+}
+
+// valuesTransform returns a values customizer that replaces the values with the first result
+// of the given jq expression, which must be an object. An expression that produces no result
+// leaves the values untouched.
+func valuesTransform(index int, expression string) helme.ValuesCustomizer {
+	return func(ctx context.Context, in map[string]interface{}) (map[string]interface{}, error) {
+		query, err := gojq.Parse(expression)
+		if err != nil {
+			return nil, fmt.Errorf("%w: valuesTransforms[%d]: unable to parse expression: %w", ErrInvalidValuesTransform, index, err)
+		}
+
+		v, ok := query.RunWithContext(ctx, in).Next()
+		if !ok {
+			return in, nil
+		}
+
+		switch r := v.(type) {
+		case error:
+			return nil, fmt.Errorf("%w: valuesTransforms[%d]: %w", ErrInvalidValuesTransform, index, r)
+		case map[string]interface{}:
+			return r, nil
+		default:
+			return nil, fmt.Errorf("%w: valuesTransforms[%d]: the expression must produce an object, got %T", ErrInvalidValuesTransform, index, v)
+		}
+	}
+}
+
+// transformValues applies the given values customizers, in order, to the given values.
+func transformValues(ctx context.Context, customizers []helme.ValuesCustomizer, in map[string]interface{}) (map[string]interface{}, error) {
+	values := in
+
+	for i := range customizers {
+		nv, err := customizers[i](ctx, values)
+		if err != nil {
+			return nil, err
+		}
+
+		values = nv
+	}
+
+	return values, nil
+}
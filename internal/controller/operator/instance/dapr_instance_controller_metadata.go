@@ -0,0 +1,87 @@
+package instance
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/podsecurity"
+)
+
+// applyCommonMetadata adds the common labels and annotations set in the spec to the given
+// rendered resources and, along with the pod labels and annotations, to the pod templates
+// they embed. The labels and annotations set by the chart take precedence, so selectors
+// are never affected, and the release labels, which the garbage collection and the
+// watches rely on, are added afterwards so they cannot be overridden.
+func applyCommonMetadata(rc *ReconciliationRequest, items []unstructured.Unstructured) error {
+	spec := rc.Resource.Spec
+
+	if len(spec.CommonLabels) == 0 && len(spec.CommonAnnotations) == 0 && len(spec.PodLabels) == 0 && len(spec.PodAnnotations) == 0 {
+		return nil
+	}
+
+	for i := range items {
+		items[i].SetLabels(withDefaults(items[i].GetLabels(), spec.CommonLabels))
+		items[i].SetAnnotations(withDefaults(items[i].GetAnnotations(), spec.CommonAnnotations))
+
+		path, ok := podsecurity.TemplatePath(&items[i])
+		if !ok {
+			continue
+		}
+
+		podLabels := withDefaults(spec.PodLabels, spec.CommonLabels)
+		podAnnotations := withDefaults(spec.PodAnnotations, spec.CommonAnnotations)
+
+		for field, values := range map[string]map[string]string{"labels": podLabels, "annotations": podAnnotations} {
+			fieldPath := slices.Concat(path, []string{"metadata", field})
+
+			current, _, err := unstructured.NestedStringMap(items[i].Object, fieldPath...)
+			if err != nil {
+				//nolint:wrapcheck
+				return err
+			}
+
+			merged := withDefaults(current, values)
+			if len(merged) == 0 {
+				continue
+			}
+
+			if err := unstructured.SetNestedStringMap(items[i].Object, merged, fieldPath...); err != nil {
+				//nolint:wrapcheck
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// withDefaults returns a copy of the given values, completed with the given defaults for the
+// keys that are not set.
+func withDefaults(values map[string]string, defaults map[string]string) map[string]string {
+	if len(values) == 0 && len(defaults) == 0 {
+		return values
+	}
+
+	answer := make(map[string]string, len(values)+len(defaults))
+
+	for k, v := range defaults {
+		answer[k] = v
+	}
+
+	for k, v := range values {
+		answer[k] = v
+	}
+
+	return answer
+}
+
+// reservedLabels are the labels managed by the controller, they cannot be set through the
+// common or pod labels.
+var reservedLabels = []string{
+	helm.ReleaseGeneration,
+	helm.ReleaseName,
+	helm.ReleaseNamespace,
+	helm.ReleaseVersion,
+}
@@ -0,0 +1,108 @@
+package instance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+var ErrInvalidPatch = errors.New("invalid patch")
+
+// applyPatches applies the given patches, in order, to the rendered resources selected by
+// their targets. It returns the indexes of the patches that did not match any resource.
+func applyPatches(patches []daprApi.Patch, items []unstructured.Unstructured) ([]int, error) {
+	unmatched := make([]int, 0)
+
+	for i := range patches {
+		p := patches[i]
+
+		selector, err := labels.Parse(p.Target.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("%w: patch %d: unable to parse label selector: %w", ErrInvalidPatch, i, err)
+		}
+
+		data, err := yaml.YAMLToJSON([]byte(p.Patch))
+		if err != nil {
+			return nil, fmt.Errorf("%w: patch %d: unable to decode patch: %w", ErrInvalidPatch, i, err)
+		}
+
+		matched := false
+
+		for j := range items {
+			if !patchTargets(p.Target, selector, &items[j]) {
+				continue
+			}
+
+			matched = true
+
+			switch p.Type {
+			case daprApi.PatchTypeJSON6902:
+				err = resources.JSONPatch(&items[j], data)
+			default:
+				err = resources.StrategicMergePatch(controller.Scheme, &items[j], data)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("%w: patch %d on %s: %w", ErrInvalidPatch, i, resources.Ref(&items[j]), err)
+			}
+		}
+
+		if !matched {
+			unmatched = append(unmatched, i)
+		}
+	}
+
+	return unmatched, nil
+}
+
+func patchTargets(t daprApi.PatchTarget, selector labels.Selector, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+
+	switch {
+	case t.Group != "" && t.Group != gvk.Group:
+		return false
+	case t.Version != "" && t.Version != gvk.Version:
+		return false
+	case t.Kind != "" && t.Kind != gvk.Kind:
+		return false
+	case t.Name != "" && t.Name != obj.GetName():
+		return false
+	default:
+		return selector.Matches(labels.Set(obj.GetLabels()))
+	}
+}
+
+// describePatches formats the targets of the patches with the given indexes.
+func describePatches(patches []daprApi.Patch, indexes []int) string {
+	items := make([]string, 0, len(indexes))
+
+	for _, i := range indexes {
+		t := patches[i].Target
+
+		fields := make([]string, 0)
+
+		for _, f := range [][2]string{
+			{"group", t.Group},
+			{"version", t.Version},
+			{"kind", t.Kind},
+			{"name", t.Name},
+			{"labelSelector", t.LabelSelector},
+		} {
+			if f[1] != "" {
+				fields = append(fields, f[0]+"="+f[1])
+			}
+		}
+
+		items = append(items, fmt.Sprintf("%d (%s)", i, strings.Join(fields, ", ")))
+	}
+
+	return strings.Join(items, ", ")
+}
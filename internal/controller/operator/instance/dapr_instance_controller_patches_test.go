@@ -0,0 +1,212 @@
+package instance_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+
+	. "github.com/onsi/gomega"
+)
+
+const operatorDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-operator
+  labels:
+    app.kubernetes.io/component: operator
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: dapr-operator
+        image: daprio/operator:1.16.1
+`
+
+const sentryDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  labels:
+    app.kubernetes.io/component: sentry
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: dapr-sentry
+        image: daprio/sentry:1.16.1
+`
+
+const sentryService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: dapr-sentry
+  labels:
+    app.kubernetes.io/component: sentry
+spec:
+  ports:
+  - port: 443
+`
+
+func replicas(g *WithT, obj *unstructured.Unstructured) int64 {
+	v, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	return v
+}
+
+func TestApplyPatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		patches   []daprApi.Patch
+		operator  int64
+		sentry    int64
+		unmatched []int
+	}{
+		{
+			name: "strategic merge by name",
+			patches: []daprApi.Patch{{
+				Target: daprApi.PatchTarget{Kind: "Deployment", Name: "dapr-operator"},
+				Patch:  "spec:\n  replicas: 2\n",
+			}},
+			operator:  2,
+			sentry:    1,
+			unmatched: []int{},
+		},
+		{
+			name: "json6902 by label selector",
+			patches: []daprApi.Patch{{
+				Target: daprApi.PatchTarget{Group: "apps", Kind: "Deployment", LabelSelector: "app.kubernetes.io/component=sentry"},
+				Type:   daprApi.PatchTypeJSON6902,
+				Patch:  `[{"op":"replace","path":"/spec/replicas","value":3}]`,
+			}},
+			operator:  1,
+			sentry:    3,
+			unmatched: []int{},
+		},
+		{
+			name: "all the matching resources",
+			patches: []daprApi.Patch{{
+				Target: daprApi.PatchTarget{Kind: "Deployment"},
+				Patch:  `{"spec":{"replicas":2}}`,
+			}},
+			operator:  2,
+			sentry:    2,
+			unmatched: []int{},
+		},
+		{
+			name: "in order",
+			patches: []daprApi.Patch{
+				{
+					Target: daprApi.PatchTarget{Name: "dapr-operator", Kind: "Deployment"},
+					Patch:  `{"spec":{"replicas":2}}`,
+				},
+				{
+					Target: daprApi.PatchTarget{Name: "dapr-operator", Kind: "Deployment"},
+					Type:   daprApi.PatchTypeJSON6902,
+					Patch:  `[{"op":"test","path":"/spec/replicas","value":2},{"op":"replace","path":"/spec/replicas","value":4}]`,
+				},
+			},
+			operator:  4,
+			sentry:    1,
+			unmatched: []int{},
+		},
+		{
+			name: "unmatched",
+			patches: []daprApi.Patch{
+				{
+					Target: daprApi.PatchTarget{Kind: "StatefulSet"},
+					Patch:  `{"spec":{"replicas":2}}`,
+				},
+				{
+					Target: daprApi.PatchTarget{Kind: "Deployment", Version: "v1beta1"},
+					Patch:  `{"spec":{"replicas":2}}`,
+				},
+			},
+			operator:  1,
+			sentry:    1,
+			unmatched: []int{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				object(t, operatorDeployment),
+				object(t, sentryDeployment),
+				object(t, sentryService),
+			}
+
+			unmatched, err := instance.ApplyPatches(tt.patches, items)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(unmatched).To(Equal(tt.unmatched))
+
+			g.Expect(replicas(g, find(items, "Deployment", "dapr-operator"))).To(Equal(tt.operator))
+			g.Expect(replicas(g, find(items, "Deployment", "dapr-sentry"))).To(Equal(tt.sentry))
+		})
+	}
+}
+
+func TestApplyPatchesInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch daprApi.Patch
+	}{
+		{
+			name: "label selector",
+			patch: daprApi.Patch{
+				Target: daprApi.PatchTarget{LabelSelector: "app in (operator"},
+				Patch:  `{"spec":{"replicas":2}}`,
+			},
+		},
+		{
+			name: "document",
+			patch: daprApi.Patch{
+				Target: daprApi.PatchTarget{Kind: "Deployment"},
+				Patch:  "spec: [",
+			},
+		},
+		{
+			name: "json6902 operation",
+			patch: daprApi.Patch{
+				Target: daprApi.PatchTarget{Kind: "Deployment"},
+				Type:   daprApi.PatchTypeJSON6902,
+				Patch:  `[{"op":"remove","path":"/spec/missing"}]`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				object(t, operatorDeployment),
+			}
+
+			_, err := instance.ApplyPatches([]daprApi.Patch{tt.patch}, items)
+			g.Expect(err).To(MatchError(instance.ErrInvalidPatch))
+		})
+	}
+}
+
+func TestDescribePatches(t *testing.T) {
+	g := NewWithT(t)
+
+	patches := []daprApi.Patch{
+		{Target: daprApi.PatchTarget{Kind: "Deployment", Name: "dapr-operator"}},
+		{Target: daprApi.PatchTarget{Group: "apps", Version: "v1", LabelSelector: "app=dapr"}},
+	}
+
+	g.Expect(instance.DescribePatches(patches, []int{0, 1})).To(Equal(
+		"0 (kind=Deployment, name=dapr-operator), 1 (group=apps, version=v1, labelSelector=app=dapr)"))
+}
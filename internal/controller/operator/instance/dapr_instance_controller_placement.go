@@ -0,0 +1,101 @@
+package instance
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const ComponentLabel = "app.kubernetes.io/component"
+
+// applyPlacement sets the scheduling settings of the given placement on the pod templates of
+// the rendered Deployments and StatefulSets, the settings of a component override the ones
+// set for all the workloads. It returns the components of the placement that do not match
+// any rendered workload.
+func applyPlacement(p *daprApi.PlacementSpec, items []unstructured.Unstructured) ([]string, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	matched := make(map[string]struct{})
+
+	for i := range items {
+		if !isWorkload(items[i].GroupVersionKind()) {
+			continue
+		}
+
+		s := p.SchedulingSpec
+
+		component := resources.Label(&items[i], ComponentLabel)
+		if cs, ok := p.Components[component]; ok {
+			s = overrideScheduling(s, cs)
+			matched[component] = struct{}{}
+		}
+
+		if err := applyScheduling(&items[i], s); err != nil {
+			return nil, fmt.Errorf("cannot apply placement to %s: %w", resources.Ref(&items[i]), err)
+		}
+	}
+
+	unmatched := make([]string, 0)
+
+	for _, c := range slices.Sorted(maps.Keys(p.Components)) {
+		if _, ok := matched[c]; !ok {
+			unmatched = append(unmatched, c)
+		}
+	}
+
+	return unmatched, nil
+}
+
+// overrideScheduling returns the given scheduling settings, with the fields that are set in
+// the given overrides replaced.
+func overrideScheduling(in daprApi.SchedulingSpec, overrides daprApi.SchedulingSpec) daprApi.SchedulingSpec {
+	answer := *in.DeepCopy()
+
+	if overrides.NodeSelector != nil {
+		answer.NodeSelector = overrides.NodeSelector
+	}
+
+	if overrides.Tolerations != nil {
+		answer.Tolerations = overrides.Tolerations
+	}
+
+	if overrides.Affinity != nil {
+		answer.Affinity = overrides.Affinity
+	}
+
+	if overrides.TopologySpreadConstraints != nil {
+		answer.TopologySpreadConstraints = overrides.TopologySpreadConstraints
+	}
+
+	if overrides.PriorityClassName != "" {
+		answer.PriorityClassName = overrides.PriorityClassName
+	}
+
+	return answer
+}
+
+// applyScheduling sets the fields of the given scheduling settings that are set, which have
+// the same name as in a pod spec, on the pod template of the given workload.
+func applyScheduling(obj *unstructured.Unstructured, s daprApi.SchedulingSpec) error {
+	fields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&s)
+	if err != nil {
+		return fmt.Errorf("cannot convert scheduling settings: %w", err)
+	}
+
+	for k, v := range fields {
+		if err := unstructured.SetNestedField(obj.Object, v, "spec", "template", "spec", k); err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+	}
+
+	return nil
+}
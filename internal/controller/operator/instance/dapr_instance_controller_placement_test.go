@@ -0,0 +1,154 @@
+package instance_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+
+	. "github.com/onsi/gomega"
+)
+
+func podSpec(g *WithT, obj *unstructured.Unstructured) map[string]interface{} {
+	spec, _, err := unstructured.NestedMap(obj.Object, "spec", "template", "spec")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	return spec
+}
+
+func TestApplyPlacement(t *testing.T) {
+	infra := map[string]string{"node-role.kubernetes.io/infra": ""}
+	critical := "system-cluster-critical"
+
+	tests := []struct {
+		name      string
+		placement *daprApi.PlacementSpec
+		operator  map[string]interface{}
+		sentry    map[string]interface{}
+		unmatched []string
+	}{
+		{
+			name:      "none",
+			placement: nil,
+			operator:  map[string]interface{}{},
+			sentry:    map[string]interface{}{},
+			unmatched: nil,
+		},
+		{
+			name: "all the workloads",
+			placement: &daprApi.PlacementSpec{
+				SchedulingSpec: daprApi.SchedulingSpec{
+					NodeSelector:      infra,
+					PriorityClassName: critical,
+				},
+			},
+			operator: map[string]interface{}{
+				"nodeSelector":      map[string]interface{}{"node-role.kubernetes.io/infra": ""},
+				"priorityClassName": critical,
+			},
+			sentry: map[string]interface{}{
+				"nodeSelector":      map[string]interface{}{"node-role.kubernetes.io/infra": ""},
+				"priorityClassName": critical,
+			},
+			unmatched: []string{},
+		},
+		{
+			name: "component overrides",
+			placement: &daprApi.PlacementSpec{
+				SchedulingSpec: daprApi.SchedulingSpec{
+					NodeSelector: infra,
+				},
+				Components: map[string]daprApi.SchedulingSpec{
+					"sentry": {
+						NodeSelector: map[string]string{"zone": "a"},
+						Tolerations: []corev1.Toleration{{
+							Key:      "dedicated",
+							Operator: corev1.TolerationOpExists,
+							Effect:   corev1.TaintEffectNoSchedule,
+						}},
+					},
+				},
+			},
+			operator: map[string]interface{}{
+				"nodeSelector": map[string]interface{}{"node-role.kubernetes.io/infra": ""},
+			},
+			sentry: map[string]interface{}{
+				"nodeSelector": map[string]interface{}{"zone": "a"},
+				"tolerations": []interface{}{map[string]interface{}{
+					"key":      "dedicated",
+					"operator": "Exists",
+					"effect":   "NoSchedule",
+				}},
+			},
+			unmatched: []string{},
+		},
+		{
+			name: "unmatched components",
+			placement: &daprApi.PlacementSpec{
+				Components: map[string]daprApi.SchedulingSpec{
+					"scheduler": {PriorityClassName: critical},
+					"operator":  {PriorityClassName: critical},
+					"placement": {PriorityClassName: critical},
+				},
+			},
+			operator: map[string]interface{}{
+				"priorityClassName": critical,
+			},
+			sentry:    map[string]interface{}{},
+			unmatched: []string{"placement", "scheduler"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				object(t, operatorDeployment),
+				object(t, sentryDeployment),
+				object(t, sentryService),
+			}
+
+			unmatched, err := instance.ApplyPlacement(tt.placement, items)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(unmatched).To(Equal(tt.unmatched))
+
+			for name, expected := range map[string]map[string]interface{}{"dapr-operator": tt.operator, "dapr-sentry": tt.sentry} {
+				spec := podSpec(g, find(items, "Deployment", name))
+
+				for k, v := range expected {
+					g.Expect(spec).To(HaveKeyWithValue(k, v), "%s: %s", name, k)
+				}
+
+				for _, k := range []string{"nodeSelector", "tolerations", "affinity", "topologySpreadConstraints", "priorityClassName"} {
+					if _, ok := expected[k]; !ok {
+						g.Expect(spec).ToNot(HaveKey(k), "%s: %s", name, k)
+					}
+				}
+			}
+
+			// only the workloads are affected
+			g.Expect(find(items, "Service", "dapr-sentry").Object).To(Equal(object(t, sentryService).Object))
+		})
+	}
+}
+
+func TestOverrideScheduling(t *testing.T) {
+	g := NewWithT(t)
+
+	in := daprApi.SchedulingSpec{
+		NodeSelector:      map[string]string{"zone": "a"},
+		PriorityClassName: "low",
+	}
+
+	out := instance.OverrideScheduling(in, daprApi.SchedulingSpec{PriorityClassName: "high"})
+
+	g.Expect(out.NodeSelector).To(Equal(map[string]string{"zone": "a"}))
+	g.Expect(out.PriorityClassName).To(Equal("high"))
+
+	// the input is left untouched
+	g.Expect(in.PriorityClassName).To(Equal("low"))
+}
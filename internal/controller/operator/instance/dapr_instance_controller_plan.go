@@ -0,0 +1,345 @@
+package instance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/controller/gc"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	ChangeCreate = "create"
+	ChangeUpdate = "update"
+	ChangePrune  = "prune"
+)
+
+type planEntry struct {
+	Action     string `json:"action"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// plan describes the changes the controller is going to apply to the cluster for a given
+// generation of the resource and chart version. The values may carry credentials, so only
+// their digest is retained, as the data of the Secrets is excluded from the manifests.
+type plan struct {
+	Chart        string      `json:"chart"`
+	Version      string      `json:"version"`
+	Generation   int64       `json:"generation"`
+	ValuesDigest string      `json:"valuesDigest,omitempty"`
+	Entries      []planEntry `json:"entries"`
+
+	// manifests holds the objects to be created or updated
+	manifests []unstructured.Unstructured
+}
+
+func (p *plan) Count(action string) int {
+	count := 0
+
+	for i := range p.Entries {
+		if p.Entries[i].Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Hash computes a hash identifying the plan. The rendered manifests are not taken into
+// account as some of them, i.e. certificates, are re-generated at every render, the plan
+// is instead identified by the inputs of the render and by the resulting changes.
+func (p *plan) Hash() (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal plan: %w", err)
+	}
+
+	h := sha256.Sum256(data)
+
+	return hex.EncodeToString(h[:]), nil
+}
+
+// Data returns the content of the ConfigMap holding the plan details.
+func (p *plan) Data() (map[string]string, error) {
+	summary, err := yaml.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal plan: %w", err)
+	}
+
+	manifests := ""
+
+	for i := range p.manifests {
+		data, err := yaml.Marshal(p.manifests[i].Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal manifest %s: %w", resources.Ref(&p.manifests[i]), err)
+		}
+
+		manifests += "---\n" + string(data)
+	}
+
+	return map[string]string{
+		"plan.yaml":      string(summary),
+		"manifests.yaml": manifests,
+	}, nil
+}
+
+func newPlanner() *planner {
+	return &planner{
+		gc: gc.New(),
+	}
+}
+
+// planner computes the changes the ApplyResourcesAction and the GCAction would apply by
+// rendering the chart, dry-running the apply of each resulting object and listing the
+// objects the garbage collector would prune.
+type planner struct {
+	gc *gc.GC
+}
+
+// Change is a change the controller would apply to an object of the release. Live is not
+// set for objects to be created, Desired is not set for objects to be pruned. For objects to
+// be updated, both are normalized so that only meaningful differences are retained.
+type Change struct {
+	Action  string
+	Live    *unstructured.Unstructured
+	Desired *unstructured.Unstructured
+}
+
+func (p *planner) Compute(ctx context.Context, rc *ReconciliationRequest) (*plan, error) {
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	changes, err := p.Changes(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := valuesDigest(rc.Helm.ChartValues)
+	if err != nil {
+		return nil, err
+	}
+
+	answer := plan{
+		Chart:        c.Name(),
+		Version:      c.Version(),
+		Generation:   rc.Resource.Generation,
+		ValuesDigest: digest,
+		Entries:      make([]planEntry, 0, len(changes)),
+		manifests:    make([]unstructured.Unstructured, 0, len(changes)),
+	}
+
+	for i := range changes {
+		if changes[i].Desired == nil {
+			answer.Entries = append(answer.Entries, newPlanEntry(changes[i].Action, changes[i].Live))
+
+			continue
+		}
+
+		answer.Entries = append(answer.Entries, newPlanEntry(changes[i].Action, changes[i].Desired))
+		answer.manifests = append(answer.manifests, redact(*changes[i].Desired))
+	}
+
+	sort.SliceStable(answer.Entries, func(i int, j int) bool {
+		if answer.Entries[i].Action != answer.Entries[j].Action {
+			return answer.Entries[i].Action < answer.Entries[j].Action
+		}
+
+		return answer.Entries[i].Key() < answer.Entries[j].Key()
+	})
+
+	return &answer, nil
+}
+
+// Changes computes the objects to be created, updated and pruned.
+func (p *planner) Changes(ctx context.Context, rc *ReconciliationRequest) ([]Change, error) {
+	c, err := rc.Chart(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	items, err := rc.Render(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render a chart: %w", err)
+	}
+
+	sortResources(items)
+
+	answer := make([]Change, 0, len(items))
+	rendered := make(map[string]struct{})
+
+	for i := range items {
+		obj := items[i]
+
+		resources.Labels(&obj, releaseLabels(rc, c.Version()))
+
+		change, err := p.change(ctx, rc, &obj)
+		if err != nil {
+			return nil, err
+		}
+
+		rendered[planKey(&obj)] = struct{}{}
+
+		if change != nil {
+			answer = append(answer, *change)
+		}
+	}
+
+	s, err := gcSelector(ctx, rc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute gc selector: %w", err)
+	}
+
+	pruned, err := p.gc.List(ctx, rc.Client, rc.Resource.Namespace, s, func(_ context.Context, obj unstructured.Unstructured) (bool, error) {
+		if _, ok := rendered[planKey(&obj)]; ok {
+			return false, nil
+		}
+
+		gen := resources.Label(&obj, helm.ReleaseGeneration)
+		if gen == "" {
+			return false, nil
+		}
+
+		g, err := strconv.Atoi(gen)
+		if err != nil {
+			return false, fmt.Errorf("cannot determine release generation: %w", err)
+		}
+
+		return rc.Resource.Generation > int64(g), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute objects to prune: %w", err)
+	}
+
+	for i := range pruned {
+		answer = append(answer, Change{
+			Action: ChangePrune,
+			Live:   &pruned[i],
+		})
+	}
+
+	return answer, nil
+}
+
+// change determines if the object would be created or updated by dry-running the apply,
+// nil is returned if the object would not change.
+func (p *planner) change(ctx context.Context, rc *ReconciliationRequest, obj *unstructured.Unstructured) (*Change, error) {
+	dc, err := rc.Client.Dynamic(rc.Resource.Namespace, obj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create dynamic client: %w", err)
+	}
+
+	if _, ok := dc.(*client.NamespacedResource); ok {
+		// the DaprInstance may not exist yet, i.e. when computing the changes before it
+		// is applied, an owner reference without uid would be rejected
+		if rc.Resource.UID != "" {
+			obj.SetOwnerReferences(resources.OwnerReferences(rc.Resource))
+		}
+
+		obj.SetNamespace(rc.Resource.Namespace)
+	}
+
+	live, err := dc.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return &Change{Action: ChangeCreate, Desired: obj}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get object %s: %w", resources.Ref(obj), err)
+	}
+
+	applied, err := dc.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: controller.FieldManager,
+		Force:        true,
+		DryRun:       []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot dry-run patch object %s: %w", resources.Ref(obj), err)
+	}
+
+	live = normalize(live)
+	applied = normalize(applied)
+
+	if equality.Semantic.DeepEqual(live.Object, applied.Object) {
+		return nil, nil
+	}
+
+	return &Change{Action: ChangeUpdate, Live: live, Desired: applied}, nil
+}
+
+func (e *planEntry) Key() string {
+	return e.APIVersion + ":" + e.Kind + ":" + e.Namespace + ":" + e.Name
+}
+
+func planKey(obj *unstructured.Unstructured) string {
+	return obj.GetAPIVersion() + ":" + obj.GetKind() + ":" + obj.GetNamespace() + ":" + obj.GetName()
+}
+
+func newPlanEntry(action string, obj *unstructured.Unstructured) planEntry {
+	return planEntry{
+		Action:     action,
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// normalize removes the fields that are changed by any apply, including the generation
+// label, so that only meaningful changes are detected.
+func normalize(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	answer := obj.DeepCopy()
+
+	unstructured.RemoveNestedField(answer.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(answer.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(answer.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(answer.Object, "metadata", "labels", helm.ReleaseGeneration)
+	unstructured.RemoveNestedField(answer.Object, "status")
+
+	return answer
+}
+
+// valuesDigest computes the digest of the given values, which identifies them without
+// disclosing their content.
+func valuesDigest(values map[string]interface{}) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal values: %w", err)
+	}
+
+	h := sha256.Sum256(data)
+
+	return hex.EncodeToString(h[:]), nil
+}
+
+// redact removes sensitive data from the manifests stored in the plan.
+func redact(obj unstructured.Unstructured) unstructured.Unstructured {
+	if obj.GroupVersionKind().Group == "" && obj.GetKind() == "Secret" {
+		unstructured.RemoveNestedField(obj.Object, "data")
+		unstructured.RemoveNestedField(obj.Object, "stringData")
+	}
+
+	return obj
+}
@@ -0,0 +1,213 @@
+package instance_test
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+func daprInstance(generation int64, values string) *daprApi.DaprInstance {
+	res := &daprApi.DaprInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       instance.DaprInstanceResourceName,
+			Namespace:  "dapr-system",
+			Generation: generation,
+		},
+	}
+
+	if values != "" {
+		res.Spec.Values = &daprApi.JSON{RawMessage: daprApi.RawMessage(values)}
+	}
+
+	return res
+}
+
+func computePlan(t *testing.T, c *client.Client, res *daprApi.DaprInstance) *instance.Plan {
+	t.Helper()
+
+	rr, err := instance.NewReconciliationRequest(c, helm.Options{ChartsDir: chartsDir}, res)
+	if err != nil {
+		t.Fatalf("invalid request: %v", err)
+	}
+
+	p, err := instance.ComputePlan(t.Context(), &rr)
+	if err != nil {
+		t.Fatalf("cannot compute plan: %v", err)
+	}
+
+	return p
+}
+
+// manifests returns the objects of the plan the controller would create or update.
+func manifests(t *testing.T, p *instance.Plan) []runtime.Object {
+	t.Helper()
+
+	data, err := p.Data()
+	if err != nil {
+		t.Fatalf("cannot get plan data: %v", err)
+	}
+
+	answer := make([]runtime.Object, 0)
+
+	for _, doc := range strings.Split(data["manifests.yaml"], "---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+
+		obj := object(t, doc)
+		answer = append(answer, &obj)
+	}
+
+	return answer
+}
+
+func TestValuesDigest(t *testing.T) {
+	g := NewWithT(t)
+
+	empty, err := instance.ValuesDigest(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(empty).To(BeEmpty())
+
+	values := map[string]interface{}{
+		"global":        map[string]interface{}{"logAsJson": true},
+		"dapr_operator": map[string]interface{}{"replicaCount": 2},
+	}
+
+	digest, err := instance.ValuesDigest(values)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(digest).To(HaveLen(64))
+
+	// the digest does not depend on the order the values are set in
+	same, err := instance.ValuesDigest(map[string]interface{}{
+		"dapr_operator": map[string]interface{}{"replicaCount": 2},
+		"global":        map[string]interface{}{"logAsJson": true},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(same).To(Equal(digest))
+
+	other, err := instance.ValuesDigest(map[string]interface{}{
+		"global":        map[string]interface{}{"logAsJson": true},
+		"dapr_operator": map[string]interface{}{"replicaCount": 3},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(other).ToNot(Equal(digest))
+}
+
+func TestComputePlan(t *testing.T) {
+	g := NewWithT(t)
+
+	res := daprInstance(1, `{"dapr_operator":{"logLevel":"debug"}}`)
+
+	p := computePlan(t, newClient(), res)
+
+	g.Expect(p.Chart).To(Equal("dapr"))
+	g.Expect(p.Version).ToNot(BeEmpty())
+	g.Expect(p.Generation).To(Equal(int64(1)))
+	g.Expect(p.ValuesDigest).To(HaveLen(64))
+	g.Expect(p.Entries).ToNot(BeEmpty())
+	g.Expect(p.Count(instance.ChangeCreate)).To(Equal(len(p.Entries)))
+	g.Expect(p.Count(instance.ChangeUpdate)).To(BeZero())
+	g.Expect(p.Count(instance.ChangePrune)).To(BeZero())
+
+	data, err := p.Data()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(data).To(HaveKey("plan.yaml"))
+	g.Expect(data["plan.yaml"]).ToNot(ContainSubstring("debug"), "the values are only retained as a digest")
+
+	// the data of the Secrets is not retained
+	secrets := 0
+
+	for _, obj := range manifests(t, p) {
+		//nolint:forcetypeassert
+		u := obj.(*unstructured.Unstructured)
+		if u.GetKind() != "Secret" {
+			continue
+		}
+
+		secrets++
+
+		g.Expect(u.Object).ToNot(HaveKey("data"))
+		g.Expect(u.Object).ToNot(HaveKey("stringData"))
+	}
+
+	g.Expect(secrets).ToNot(BeZero())
+
+	// the plan is identified by the inputs of the render, not by the generated certificates
+	h1, err := p.Hash()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	h2, err := computePlan(t, newClient(), res).Hash()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(h2).To(Equal(h1))
+
+	h3, err := computePlan(t, newClient(), daprInstance(1, `{"dapr_operator":{"logLevel":"info"}}`)).Hash()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(h3).ToNot(Equal(h1))
+}
+
+func TestComputePlanChanges(t *testing.T) {
+	g := NewWithT(t)
+
+	res := daprInstance(1, "")
+
+	// the objects are applied as planned, so nothing is left to change
+	live := manifests(t, computePlan(t, newClient(), res))
+
+	p := computePlan(t, newClient(live...), res)
+	g.Expect(p.Entries).To(BeEmpty())
+
+	// a changed object is updated
+	for _, obj := range live {
+		//nolint:forcetypeassert
+		u := obj.(*unstructured.Unstructured)
+		if u.GetKind() == "Deployment" && u.GetName() == "dapr-operator" {
+			g.Expect(unstructured.SetNestedField(u.Object, int64(5), "spec", "replicas")).To(Succeed())
+		}
+	}
+
+	p = computePlan(t, newClient(live...), res)
+	g.Expect(p.Entries).To(HaveLen(1))
+	g.Expect(p.Entries[0].Action).To(Equal(instance.ChangeUpdate))
+	g.Expect(p.Entries[0].Kind).To(Equal("Deployment"))
+	g.Expect(p.Entries[0].Name).To(Equal("dapr-operator"))
+
+	// an object of a previous generation that is not rendered anymore is pruned
+	stale := object(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: dapr-stale
+  namespace: dapr-system
+`)
+
+	stale.SetLabels(map[string]string{
+		helm.ReleaseGeneration: "1",
+		helm.ReleaseName:       res.Name,
+		helm.ReleaseNamespace:  res.Namespace,
+		helm.ReleaseVersion:    p.Version,
+	})
+
+	p = computePlan(t, newClient(append(live, &stale)...), daprInstance(2, ""))
+	g.Expect(p.Count(instance.ChangePrune)).To(Equal(1))
+
+	for _, e := range p.Entries {
+		if e.Action == instance.ChangePrune {
+			g.Expect(e.Kind).To(Equal("ConfigMap"))
+			g.Expect(e.Name).To(Equal("dapr-stale"))
+		}
+	}
+
+	// while it is retained by the generation it belongs to
+	p = computePlan(t, newClient(append(live, &stale)...), res)
+	g.Expect(p.Count(instance.ChangePrune)).To(BeZero())
+}
@@ -0,0 +1,329 @@
+package instance
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+const (
+	// OpenShiftServingCertAnnotation asks the OpenShift service CA operator to generate a
+	// serving certificate for a Service and to store it in the Secret named after the value.
+	OpenShiftServingCertAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// OpenShiftInjectCABundleAnnotation asks the OpenShift service CA operator to inject the
+	// service CA bundle in the clientConfig of the webhooks of a webhook configuration.
+	OpenShiftInjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+
+	ServiceSidecarInjector = "dapr-sidecar-injector"
+	ServiceDashboard       = "dapr-dashboard"
+
+	DeploymentSidecarInjector = "dapr-sidecar-injector"
+	WebhookSidecarInjector    = "dapr-sidecar-injector"
+)
+
+// clusterProfile adjusts the rendered resources to the type of cluster the control plane
+// runs on.
+type clusterProfile interface {
+	Name() controller.ClusterType
+	Apply(rr *ReconciliationRequest, items []unstructured.Unstructured) ([]unstructured.Unstructured, error)
+
+	// Reconcilable returns true if the given object, that would be install-only because
+	// of the values generated at each rendering, can be reconciled continuously as the
+	// profile has replaced the generated values.
+	Reconcilable(obj *unstructured.Unstructured) bool
+}
+
+func profileFor(t controller.ClusterType) clusterProfile {
+	if t == controller.ClusterTypeOpenShift {
+		return &openShiftProfile{}
+	}
+
+	return &vanillaProfile{}
+}
+
+// vanillaProfile leaves the rendered resources untouched.
+type vanillaProfile struct {
+}
+
+func (p *vanillaProfile) Name() controller.ClusterType {
+	return controller.ClusterTypeVanilla
+}
+
+func (p *vanillaProfile) Apply(_ *ReconciliationRequest, items []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	return items, nil
+}
+
+func (p *vanillaProfile) Reconcilable(_ *unstructured.Unstructured) bool {
+	return false
+}
+
+// openShiftProfile adjusts the rendered resources to OpenShift:
+//
+// - the hard-coded runAsUser, runAsGroup and fsGroup are dropped so the restricted security
+// context constraints can assign them out of the ranges of the namespace
+// - if the sidecar injector serves the webhook with a certificate generated by the chart,
+// the certificate is generated by the service CA instead and the service CA bundle is
+// injected in the webhook configuration, the Services are annotated for a serving
+// certificate only in this case, as otherwise nothing would consume it
+// - the dashboard, if rendered, is optionally exposed through a Route
+//
+// The service CA is not supported for the sidecar injectors that get their certificate from
+// sentry, which includes the 1.16 chart shipped with the operator: they cannot be configured
+// to read a certificate from a Secret and keep the CA bundle of the webhook configuration in
+// sync with the trust anchors themselves, so the service CA would override it with a bundle
+// that does not trust the served certificate. Their webhook configuration stays install-only.
+//
+// Sentry is not given a serving certificate by the service CA: it is the certificate
+// authority of the control plane and serves with a certificate issued from its own trust
+// bundle, which is what the sidecars and the other control plane services verify, so a
+// certificate issued by the service CA would not be trusted.
+type openShiftProfile struct {
+}
+
+func (p *openShiftProfile) Name() controller.ClusterType {
+	return controller.ClusterTypeOpenShift
+}
+
+func (p *openShiftProfile) Apply(rr *ReconciliationRequest, items []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	var dashboard *unstructured.Unstructured
+	var injector *unstructured.Unstructured
+	var injectorService *unstructured.Unstructured
+	var webhook *unstructured.Unstructured
+
+	secrets := make(map[string]struct{})
+
+	for i := range items {
+		gvk := items[i].GroupVersionKind()
+
+		switch {
+		case isWorkload(gvk):
+			if err := dropPodIdentity(&items[i]); err != nil {
+				return nil, fmt.Errorf("cannot adjust security context of %s: %w", resources.Ref(&items[i]), err)
+			}
+
+			if gvk.Kind == "Deployment" && items[i].GetName() == DeploymentSidecarInjector {
+				injector = &items[i]
+			}
+		case gvk.Group == "" && gvk.Kind == "Secret":
+			secrets[items[i].GetName()] = struct{}{}
+		case gvk.Group == "" && gvk.Kind == "Service":
+			switch items[i].GetName() {
+			case ServiceSidecarInjector:
+				injectorService = &items[i]
+			case ServiceDashboard:
+				// a copy, as the items may be removed below
+				dashboard = items[i].DeepCopy()
+			}
+		case gvk.Group == "admissionregistration.k8s.io" && gvk.Kind == "MutatingWebhookConfiguration":
+			if items[i].GetName() == WebhookSidecarInjector {
+				webhook = &items[i]
+			}
+		}
+	}
+
+	if injector != nil && injectorService != nil && webhook != nil {
+		secret, err := injectorCertSecret(injector, secrets)
+		if err != nil {
+			return nil, fmt.Errorf("cannot determine the certificate of %s: %w", resources.Ref(injector), err)
+		}
+
+		if secret != "" {
+			if err := useServiceCA(injectorService, webhook, secret); err != nil {
+				return nil, fmt.Errorf("cannot inject the service CA in %s: %w", resources.Ref(webhook), err)
+			}
+
+			// the Secret is generated by the service CA
+			items = slices.DeleteFunc(items, func(obj unstructured.Unstructured) bool {
+				return obj.GetKind() == "Secret" && obj.GetName() == secret
+			})
+		}
+	}
+
+	cp := rr.Resource.Spec.ClusterProfile
+	if dashboard == nil || cp == nil || cp.OpenShift == nil || cp.OpenShift.DashboardRoute == nil {
+		return items, nil
+	}
+
+	route, err := dashboardRoute(dashboard, cp.OpenShift.DashboardRoute.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(items, route), nil
+}
+
+// Reconcilable returns true for the webhook configurations the service CA bundle is injected
+// in, as they do not carry a CA bundle generated by the chart anymore.
+func (p *openShiftProfile) Reconcilable(obj *unstructured.Unstructured) bool {
+	if obj.GroupVersionKind().Group != "admissionregistration.k8s.io" {
+		return false
+	}
+
+	return obj.GetAnnotations()[OpenShiftInjectCABundleAnnotation] == "true"
+}
+
+// dropPodIdentity removes the user and group identities from the pod template of the given
+// workload, both at pod and at container level.
+func dropPodIdentity(obj *unstructured.Unstructured) error {
+	psc, ok, err := unstructured.NestedMap(obj.Object, "spec", "template", "spec", "securityContext")
+	if err != nil {
+		//nolint:wrapcheck
+		return err
+	}
+
+	if ok {
+		delete(psc, "runAsUser")
+		delete(psc, "runAsGroup")
+		delete(psc, "fsGroup")
+
+		if err := unstructured.SetNestedMap(obj.Object, psc, "spec", "template", "spec", "securityContext"); err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, ok, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", field)
+		if err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		for i := range containers {
+			container, ok := containers[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			csc, ok := container["securityContext"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			delete(csc, "runAsUser")
+			delete(csc, "runAsGroup")
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, "spec", "template", "spec", field); err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dashboardRoute creates an edge terminated Route targeting the first port of the given
+// dashboard Service.
+func dashboardRoute(svc *unstructured.Unstructured, host string) (unstructured.Unstructured, error) {
+	ports, _, err := unstructured.NestedSlice(svc.Object, "spec", "ports")
+	if err != nil {
+		//nolint:wrapcheck
+		return unstructured.Unstructured{}, err
+	}
+
+	spec := map[string]interface{}{
+		"to": map[string]interface{}{
+			"kind": "Service",
+			"name": svc.GetName(),
+		},
+		"tls": map[string]interface{}{
+			"termination":                   "edge",
+			"insecureEdgeTerminationPolicy": "Redirect",
+		},
+	}
+
+	if len(ports) > 0 {
+		if port, ok := ports[0].(map[string]interface{}); ok {
+			target := port["name"]
+			if target == nil || target == "" {
+				target = port["port"]
+			}
+
+			spec["port"] = map[string]interface{}{
+				"targetPort": target,
+			}
+		}
+	}
+
+	if host != "" {
+		spec["host"] = host
+	}
+
+	route := unstructured.Unstructured{}
+	route.SetAPIVersion("route.openshift.io/v1")
+	route.SetKind("Route")
+	route.SetName(svc.GetName())
+	route.SetNamespace(svc.GetNamespace())
+	route.SetLabels(svc.GetLabels())
+	route.Object["spec"] = spec
+
+	return route, nil
+}
+
+// injectorCertSecret returns the name of the rendered Secret mounted by the given sidecar
+// injector Deployment, which holds the serving certificate generated by the chart, if any.
+// The sidecar injectors that get their certificate from sentry do not mount any.
+func injectorCertSecret(injector *unstructured.Unstructured, secrets map[string]struct{}) (string, error) {
+	volumes, _, err := unstructured.NestedSlice(injector.Object, "spec", "template", "spec", "volumes")
+	if err != nil {
+		//nolint:wrapcheck
+		return "", err
+	}
+
+	for i := range volumes {
+		volume, ok := volumes[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _, err := unstructured.NestedString(volume, "secret", "secretName")
+		if err != nil {
+			//nolint:wrapcheck
+			return "", err
+		}
+
+		if _, ok := secrets[name]; ok {
+			return name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// useServiceCA makes the service CA generate the serving certificate of the given Service in
+// the given Secret, and inject the related CA bundle in the given webhook configuration in
+// place of the one generated by the chart.
+func useServiceCA(svc *unstructured.Unstructured, webhook *unstructured.Unstructured, secret string) error {
+	resources.Annotations(svc, map[string]string{
+		OpenShiftServingCertAnnotation: secret,
+	})
+
+	resources.Annotations(webhook, map[string]string{
+		OpenShiftInjectCABundleAnnotation: "true",
+	})
+
+	webhooks, ok, err := unstructured.NestedSlice(webhook.Object, "webhooks")
+	if err != nil || !ok {
+		//nolint:wrapcheck
+		return err
+	}
+
+	for i := range webhooks {
+		if w, ok := webhooks[i].(map[string]interface{}); ok {
+			unstructured.RemoveNestedField(w, "clientConfig", "caBundle")
+		}
+	}
+
+	//nolint:wrapcheck
+	return unstructured.SetNestedSlice(webhook.Object, webhooks, "webhooks")
+}
@@ -0,0 +1,167 @@
+package instance_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+)
+
+const injectorService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: dapr-sidecar-injector
+spec:
+  ports:
+  - port: 443
+`
+
+const injectorWebhook = `
+apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: dapr-sidecar-injector
+webhooks:
+- name: sidecar-injector.dapr.io
+  clientConfig:
+    caBundle: Y2EK
+    service:
+      name: dapr-sidecar-injector
+`
+
+const injectorCertSecretFixture = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: dapr-sidecar-injector-cert
+data:
+  tls.crt: Y2VydAo=
+`
+
+// injectorWithCert mounts the Secret generated by the chart with genSignedCert, as the
+// sidecar injector did before getting its certificate from sentry.
+const injectorWithCert = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sidecar-injector
+spec:
+  template:
+    spec:
+      securityContext:
+        runAsUser: 1000
+      containers:
+      - name: dapr-sidecar-injector
+        securityContext:
+          runAsUser: 1000
+      volumes:
+      - name: cert
+        secret:
+          secretName: dapr-sidecar-injector-cert
+`
+
+// injectorWithSentry gets its certificate from sentry, as the 1.16 chart does.
+const injectorWithSentry = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sidecar-injector
+spec:
+  template:
+    spec:
+      containers:
+      - name: dapr-sidecar-injector
+      volumes:
+      - name: dapr-trust-bundle
+        configMap:
+          name: dapr-trust-bundle
+`
+
+func TestOpenShiftProfileServiceCA(t *testing.T) {
+	tests := []struct {
+		name      string
+		injector  string
+		serviceCA bool
+	}{
+		{name: "certificate generated by the chart", injector: injectorWithCert, serviceCA: true},
+		{name: "certificate from sentry", injector: injectorWithSentry, serviceCA: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			items := []unstructured.Unstructured{
+				object(t, tt.injector),
+				object(t, injectorService),
+				object(t, injectorWebhook),
+				object(t, injectorCertSecretFixture),
+			}
+
+			rr := instance.ReconciliationRequest{Resource: &daprApi.DaprInstance{}}
+			p := instance.ProfileFor(controller.ClusterTypeOpenShift)
+
+			items, err := p.Apply(&rr, items)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			svc := find(items, "Service", instance.ServiceSidecarInjector)
+			g.Expect(svc).ToNot(BeNil())
+
+			webhook := find(items, "MutatingWebhookConfiguration", instance.WebhookSidecarInjector)
+			g.Expect(webhook).ToNot(BeNil())
+
+			webhooks, _, err := unstructured.NestedSlice(webhook.Object, "webhooks")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(webhooks).To(HaveLen(1))
+
+			_, hasCABundle, err := unstructured.NestedString(webhooks[0].(map[string]interface{}), "clientConfig", "caBundle")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			if tt.serviceCA {
+				g.Expect(svc.GetAnnotations()).To(HaveKeyWithValue(instance.OpenShiftServingCertAnnotation, "dapr-sidecar-injector-cert"))
+				g.Expect(webhook.GetAnnotations()).To(HaveKeyWithValue(instance.OpenShiftInjectCABundleAnnotation, "true"))
+				g.Expect(hasCABundle).To(BeFalse())
+				g.Expect(find(items, "Secret", "dapr-sidecar-injector-cert")).To(BeNil())
+				g.Expect(p.Reconcilable(webhook)).To(BeTrue())
+			} else {
+				g.Expect(svc.GetAnnotations()).ToNot(HaveKey(instance.OpenShiftServingCertAnnotation))
+				g.Expect(webhook.GetAnnotations()).ToNot(HaveKey(instance.OpenShiftInjectCABundleAnnotation))
+				g.Expect(hasCABundle).To(BeTrue())
+				g.Expect(find(items, "Secret", "dapr-sidecar-injector-cert")).ToNot(BeNil())
+				g.Expect(p.Reconcilable(webhook)).To(BeFalse())
+			}
+
+			injector := find(items, "Deployment", instance.DeploymentSidecarInjector)
+			g.Expect(injector).ToNot(BeNil())
+
+			_, hasRunAsUser, err := unstructured.NestedFieldNoCopy(injector.Object, "spec", "template", "spec", "securityContext", "runAsUser")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(hasRunAsUser).To(BeFalse())
+		})
+	}
+}
+
+func TestVanillaProfileLeavesWebhookInstallOnly(t *testing.T) {
+	g := NewWithT(t)
+
+	items := []unstructured.Unstructured{
+		object(t, injectorWithCert),
+		object(t, injectorService),
+		object(t, injectorWebhook),
+		object(t, injectorCertSecretFixture),
+	}
+
+	rr := instance.ReconciliationRequest{Resource: &daprApi.DaprInstance{}}
+	p := instance.ProfileFor(controller.ClusterTypeVanilla)
+
+	out, err := p.Apply(&rr, items)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(out).To(Equal(items))
+	g.Expect(p.Reconcilable(find(out, "MutatingWebhookConfiguration", instance.WebhookSidecarInjector))).To(BeFalse())
+}
@@ -24,7 +24,12 @@ import (
 	"sort"
 
 	"github.com/dapr/kubernetes-operator/pkg/controller"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/sizing"
+	"github.com/dapr/kubernetes-operator/pkg/utils/maputils"
+	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
+	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/dapr/kubernetes-operator/pkg/conditions"
 
@@ -34,42 +39,72 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 )
 
 func (r *Reconciler) reconciliationRequest(res *daprApi.DaprInstance) (ReconciliationRequest, error) {
+	rr, err := newReconciliationRequest(r.Client(), r.helmEngine, r.helmCharts, r.helmOptions, res)
+	if err != nil {
+		return ReconciliationRequest{}, err
+	}
+
+	rr.ClusterType = r.ClusterType
+	rr.Reconciler = r
+
+	return rr, nil
+}
+
+func newReconciliationRequest(
+	c *client.Client,
+	engine *helme.Instance,
+	charts *helmCharts,
+	o helm.Options,
+	res *daprApi.DaprInstance,
+) (ReconciliationRequest, error) {
 	rr := ReconciliationRequest{
-		Client: r.Client(),
+		Client: c,
 		NamespacedName: types.NamespacedName{
 			Name:      res.Name,
 			Namespace: res.Namespace,
 		},
-		ClusterType: r.ClusterType,
-		Reconciler:  r,
-		Resource:    res,
+		Resource:       res,
+		InstalledChart: res.Status.Chart.DeepCopy(),
 		Helm: Helm{
-			engine:      r.helmEngine,
-			chartDir:    r.helmOptions.ChartsDir,
-			ChartValues: make(map[string]interface{}),
-			chartOverrides: map[string]interface{}{
-				"dapr_operator":         map[string]interface{}{"runAsNonRoot": "true"},
-				"dapr_placement":        map[string]interface{}{"runAsNonRoot": "true"},
-				"dapr_sentry":           map[string]interface{}{"runAsNonRoot": "true"},
-				"dapr_dashboard":        map[string]interface{}{"runAsNonRoot": "true"},
-				"dapr_sidecar_injector": map[string]interface{}{"runAsNonRoot": true},
-			},
+			engine:   engine,
+			charts:   charts,
+			chartDir: o.ChartsDir,
 		},
 	}
 
-	if res.Spec.Values != nil {
-		if err := json.Unmarshal(res.Spec.Values.RawMessage, &rr.Helm.ChartValues); err != nil {
-			return ReconciliationRequest{}, fmt.Errorf("unable to decode chart values: %w", err)
-		}
+	values, err := chartValues(&res.Spec)
+	if err != nil {
+		return ReconciliationRequest{}, err
 	}
 
+	rr.Helm.ChartValues = values
+
 	return rr, nil
 }
 
+// chartValues computes the chart values out of the values and of the sizing profile set in
+// the given spec, the values take precedence over the ones of the sizing profile.
+func chartValues(spec *daprApi.DaprInstanceSpec) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	if spec.Values != nil {
+		if err := json.Unmarshal(spec.Values.RawMessage, &values); err != nil {
+			return nil, fmt.Errorf("unable to decode chart values: %w", err)
+		}
+	}
+
+	sv, err := sizing.Values(spec.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute chart values: %w", err)
+	}
+
+	return maputils.Merge(sv, values), nil
+}
+
 func (r *Reconciler) Reconcile(ctx context.Context, res *daprApi.DaprInstance) (ctrl.Result, error) {
 	rr, err := r.reconciliationRequest(res)
 	if err != nil {
@@ -101,22 +136,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, res *daprApi.DaprInstance) (
 	for i := range r.actions {
 		if err := r.actions[i].Run(ctx, &rr); err != nil {
 			errs = append(errs, err)
+
+			rr.failed = true
 		}
 	}
 
-	if len(errs) > 0 {
+	switch {
+	case len(errs) > 0:
 		reconcileCondition.Status = metav1.ConditionFalse
 		reconcileCondition.Reason = conditions.ReasonFailure
 		reconcileCondition.Message = conditions.ReasonFailure
 
 		rr.Resource.Status.Phase = conditions.TypeError
-	} else {
+
+		// the chart has not been fully applied, keep the one previously installed
+		// so the next reconciliation detects the chart change
+		rr.Resource.Status.Chart = rr.InstalledChart
+	case rr.Held():
+		reconcileCondition.Status = metav1.ConditionFalse
+		reconcileCondition.Reason = rr.hold.Reason
+		reconcileCondition.Message = rr.hold.Message
+
+		rr.Resource.Status.Phase = conditions.TypePending
+
+		// the changes have not been applied, keep the chart previously installed and
+		// do not advance the observed generation so the changes are applied once the
+		// hold is lifted
+		rr.Resource.Status.Chart = rr.InstalledChart
+	default:
 		rr.Resource.Status.ObservedGeneration = rr.Resource.Generation
 		rr.Resource.Status.Phase = conditions.TypeReady
 	}
 
 	meta.SetStatusCondition(&rr.Resource.Status.Conditions, reconcileCondition)
 
+	rr.Resource.Status.ClusterProfile = string(profileFor(rr.ClusterType).Name())
+
 	sort.SliceStable(rr.Resource.Status.Conditions, func(i, j int) bool {
 		return rr.Resource.Status.Conditions[i].Type < rr.Resource.Status.Conditions[j].Type
 	})
@@ -124,14 +179,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, res *daprApi.DaprInstance) (
 	err = r.Client().ApplyStatus(
 		ctx,
 		rr.Resource,
-		client.ForceOwnership,
-		client.FieldOwner(controller.FieldManager),
+		ctrlCli.ForceOwnership,
+		ctrlCli.FieldOwner(controller.FieldManager),
 	)
 	if err != nil {
 		errs = append(errs, err)
 	}
 
-	return ctrl.Result{}, errors.Join(errs...)
+	if len(errs) > 0 {
+		return ctrl.Result{}, errors.Join(errs...)
+	}
+
+	return ctrl.Result{RequeueAfter: rr.requeueAfter}, nil
 }
 
 func (r *Reconciler) Cleanup(ctx context.Context, res *daprApi.DaprInstance) error {
@@ -0,0 +1,80 @@
+package instance
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+// workloadsSizing computes the effective sizing of the given workloads.
+func workloadsSizing(workloads []unstructured.Unstructured) ([]daprApi.WorkloadSizing, error) {
+	answer := make([]daprApi.WorkloadSizing, 0, len(workloads))
+
+	for i := range workloads {
+		s, err := workloadSizing(&workloads[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute sizing of %s: %w", resources.Ref(&workloads[i]), err)
+		}
+
+		answer = append(answer, s)
+	}
+
+	return answer, nil
+}
+
+func workloadSizing(obj *unstructured.Unstructured) (daprApi.WorkloadSizing, error) {
+	answer := daprApi.WorkloadSizing{
+		Kind: obj.GetKind(),
+		Name: obj.GetName(),
+	}
+
+	var template corev1.PodTemplateSpec
+
+	switch obj.GroupVersionKind().Kind {
+	case "Deployment":
+		d := appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &d); err != nil {
+			return answer, fmt.Errorf("cannot convert to Deployment: %w", err)
+		}
+
+		answer.Replicas = d.Spec.Replicas
+		template = d.Spec.Template
+	case "StatefulSet":
+		s := appsv1.StatefulSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &s); err != nil {
+			return answer, fmt.Errorf("cannot convert to StatefulSet: %w", err)
+		}
+
+		answer.Replicas = s.Spec.Replicas
+		template = s.Spec.Template
+
+		for _, c := range s.Spec.VolumeClaimTemplates {
+			size, ok := c.Spec.Resources.Requests[corev1.ResourceStorage]
+			if !ok {
+				continue
+			}
+
+			if answer.StorageSize == nil {
+				answer.StorageSize = make(map[string]resource.Quantity)
+			}
+
+			answer.StorageSize[c.Name] = size
+		}
+	}
+
+	for _, c := range template.Spec.Containers {
+		answer.Containers = append(answer.Containers, daprApi.ContainerSizing{
+			Name:      c.Name,
+			Resources: c.Resources,
+		})
+	}
+
+	return answer, nil
+}
@@ -3,10 +3,12 @@ package instance
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/dapr/kubernetes-operator/pkg/helm"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +19,27 @@ import (
 	"github.com/dapr/kubernetes-operator/pkg/controller/predicates"
 )
 
+// releaseLabels returns the labels added by the controller to all the rendered resources.
+func releaseLabels(rc *ReconciliationRequest, version string) map[string]string {
+	return map[string]string{
+		helm.ReleaseGeneration: strconv.FormatInt(rc.Resource.Generation, 10),
+		helm.ReleaseName:       rc.Resource.Name,
+		helm.ReleaseNamespace:  rc.Resource.Namespace,
+		helm.ReleaseVersion:    version,
+	}
+}
+
+// sortResources sorts the rendered resources in the order they are applied.
+func sortResources(items []unstructured.Unstructured) {
+	// TODO: this must be ordered by priority/relations
+	sort.Slice(items, func(i int, j int) bool {
+		istr := items[i].GroupVersionKind().Kind + ":" + items[i].GetName()
+		jstr := items[j].GroupVersionKind().Kind + ":" + items[j].GetName()
+
+		return istr < jstr
+	})
+}
+
 func gcSelector(ctx context.Context, rc *ReconciliationRequest) (labels.Selector, error) {
 	c, err := rc.Chart(ctx)
 	if err != nil {
@@ -0,0 +1,188 @@
+package instance_test
+
+import (
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	discoveryFake "k8s.io/client-go/discovery/fake"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	ctrlFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+)
+
+func scheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+
+	utilruntime.Must(clientgoscheme.AddToScheme(s))
+	utilruntime.Must(daprApi.AddToScheme(s))
+
+	return s
+}
+
+// restMapper maps the resources the tests deal with, in place of the discovery.
+func restMapper() meta.RESTMapper {
+	m := meta.NewDefaultRESTMapper(nil)
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+		{Group: "", Version: "v1", Kind: "Secret"},
+		{Group: "", Version: "v1", Kind: "Service"},
+		{Group: "", Version: "v1", Kind: "ConfigMap"},
+		{Group: "", Version: "v1", Kind: "ServiceAccount"},
+		{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"},
+		{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+		{Group: "dapr.io", Version: "v1alpha1", Kind: "Configuration"},
+	} {
+		m.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "scheduling.k8s.io", Version: "v1", Kind: "PriorityClass"},
+		{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"},
+	} {
+		m.Add(gvk, meta.RESTScopeRoot)
+	}
+
+	return m
+}
+
+// newClient returns a client backed by fake clients, each of them tracking the given objects.
+func newClient(objects ...runtime.Object) *client.Client {
+	s := scheme()
+
+	// the Kubernetes clientset only knows about the typed Kubernetes objects
+	typed := make([]runtime.Object, 0, len(objects))
+
+	for _, obj := range objects {
+		if _, ok := obj.(*unstructured.Unstructured); ok {
+			continue
+		}
+
+		if _, _, err := clientgoscheme.Scheme.ObjectKinds(obj); err == nil {
+			typed = append(typed, obj)
+		}
+	}
+
+	kc := kubeFake.NewClientset(typed...)
+
+	//nolint:forcetypeassert
+	discovery := namespacedDiscovery{FakeDiscovery: kc.Discovery().(*discoveryFake.FakeDiscovery)}
+
+	// the garbage collector discovers the namespaced resources it is allowed to delete
+	discovery.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"delete"}},
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"delete"}},
+				{Name: "services", Kind: "Service", Namespaced: true, Verbs: []string{"delete"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"delete"}},
+				{Name: "statefulsets", Kind: "StatefulSet", Namespaced: true, Verbs: []string{"delete"}},
+			},
+		},
+	}
+
+	kc.PrependReactor("create", "selfsubjectrulesreviews", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectRulesReview{
+			Status: authorizationv1.SubjectRulesReviewStatus{
+				ResourceRules: []authorizationv1.ResourceRule{{
+					Verbs:     []string{"*"},
+					APIGroups: []string{"*"},
+					Resources: []string{"*"},
+				}},
+			},
+		}, nil
+	})
+
+	dc := dynamicFake.NewSimpleDynamicClient(s, objects...)
+
+	// the fake client can neither apply unstructured objects nor dry-run, the applies are
+	// answered with the applied object without storing it, as the API server would do for a
+	// dry-run apply when none of the fields is defaulted
+	dc.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		//nolint:forcetypeassert
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+
+		obj := unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+
+		return true, &obj, nil
+	})
+
+	c := client.NewClientFor(
+		s,
+		ctrlFake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objects...).Build(),
+		kc,
+		dc,
+		restMapper(),
+	)
+
+	c.Discovery = discovery
+
+	return c
+}
+
+// namespacedDiscovery serves the fake resources as the preferred namespaced ones, which the
+// fake discovery does not.
+type namespacedDiscovery struct {
+	*discoveryFake.FakeDiscovery
+}
+
+func (d namespacedDiscovery) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	return d.Resources, nil
+}
+
+func object(t *testing.T, data string) unstructured.Unstructured {
+	t.Helper()
+
+	doc, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+
+	obj := unstructured.Unstructured{}
+
+	if err := obj.UnmarshalJSON(doc); err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+
+	return obj
+}
+
+func find(items []unstructured.Unstructured, kind string, name string) *unstructured.Unstructured {
+	for i := range items {
+		if items[i].GetKind() == kind && items[i].GetName() == name {
+			return &items[i]
+		}
+	}
+
+	return nil
+}
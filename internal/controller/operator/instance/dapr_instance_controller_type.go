@@ -3,23 +3,44 @@ package instance
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/lburgazzoli/k8s-manifests-renderer-helm/engine/customizers/values"
+	"helm.sh/helm/v3/pkg/chart"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
 
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
 	"github.com/dapr/kubernetes-operator/pkg/controller"
 	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/podsecurity"
+	"github.com/dapr/kubernetes-operator/pkg/pointer"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
 	"k8s.io/apimachinery/pkg/types"
+	psaApi "k8s.io/pod-security-admission/api"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
 
 const (
 	DaprInstanceFinalizerName = "instance.operator.dapr.io/finalizer"
 	DaprInstanceResourceName  = "dapr-instance"
+
+	// DaprInstanceUpgradeOverrideAnnotation allows chart version transitions that are not
+	// supported by Dapr, such as downgrades or skipping minor versions, when set to true.
+	DaprInstanceUpgradeOverrideAnnotation = "operator.dapr.io/upgrade-override"
+
+	// DaprInstanceMaintenanceOverrideAnnotation allows changes to be applied outside the
+	// maintenance windows when set to true.
+	DaprInstanceMaintenanceOverrideAnnotation = "operator.dapr.io/maintenance-override"
+
+	// DaprInstanceApprovedPlanAnnotation holds the hash of the approved plan when the
+	// approval policy is Manual.
+	DaprInstanceApprovedPlanAnnotation = "operator.dapr.io/approved-plan"
 )
 
 type ReconciliationRequest struct {
@@ -30,14 +51,114 @@ type ReconciliationRequest struct {
 	ClusterType controller.ClusterType
 	Resource    *daprApi.DaprInstance
 	Helm        Helm
+
+	// InstalledChart is a snapshot of the chart recorded in the resource status before
+	// the reconciliation started, it is used to detect chart changes that are not the
+	// result of a spec change, as it happens when a version constraint is resolved to
+	// a new version.
+	InstalledChart *daprApi.ChartMeta
+
+	requeueAfter time.Duration
+	hold         *Hold
+	failed       bool
+}
+
+// Hold describes why the changes to the live release are being held back.
+type Hold struct {
+	Reason  string
+	Message string
 }
 
 type Helm struct {
-	engine         *helme.Instance
-	chart          *helme.Chart
-	chartDir       string
-	ChartValues    map[string]interface{}
-	chartOverrides map[string]interface{}
+	engine          *helme.Instance
+	charts          *helmCharts
+	chart           *helme.Chart
+	resources       []unstructured.Unstructured
+	chartDir        string
+	chartConstraint string
+	chartResolvedAt *metav1.Time
+	ChartValues     map[string]interface{}
+
+	// unmatchedPatches holds the indexes of the patches that did not match any rendered
+	// resource, it is computed when rendering.
+	unmatchedPatches []int
+
+	// unmatchedComponents holds the components of the placement that did not match any
+	// rendered workload, it is computed when rendering.
+	unmatchedComponents []string
+}
+
+// RequeueAfter asks for the resource to be reconciled again after the given duration,
+// if invoked multiple times, the shortest duration wins.
+func (rr *ReconciliationRequest) RequeueAfter(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	if rr.requeueAfter == 0 || d < rr.requeueAfter {
+		rr.requeueAfter = d
+	}
+}
+
+// Hold prevents the actions from changing the live release, which is kept running as it is
+// until the hold is lifted. If invoked multiple times, the first hold wins.
+func (rr *ReconciliationRequest) Hold(reason string, message string) {
+	if rr.hold != nil {
+		return
+	}
+
+	rr.hold = &Hold{
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+// Held returns true if the changes to the live release are being held back.
+func (rr *ReconciliationRequest) Held() bool {
+	return rr.hold != nil
+}
+
+// Failed returns true if any of the actions run so far in the reconciliation has failed.
+func (rr *ReconciliationRequest) Failed() bool {
+	return rr.failed
+}
+
+// PodSecurityLevel returns the Pod Security Standards level the workloads are adjusted to and
+// validated against, false if not set, in which case the pod security is not enforced.
+func (rr *ReconciliationRequest) PodSecurityLevel() (psaApi.Level, bool) {
+	if rr.Resource.Spec.Security == nil || rr.Resource.Spec.Security.PodSecurity == "" {
+		return "", false
+	}
+
+	return psaApi.Level(rr.Resource.Spec.Security.PodSecurity), true
+}
+
+// PodDisruptionBudgetPolicy returns the policy the PodDisruptionBudgets of the control plane
+// workloads are managed with, Managed unless set otherwise.
+func (rr *ReconciliationRequest) PodDisruptionBudgetPolicy() daprApi.PodDisruptionBudgetPolicy {
+	if rr.Resource.Spec.Availability == nil || rr.Resource.Spec.Availability.PodDisruptionBudgets == "" {
+		return daprApi.PodDisruptionBudgetPolicyManaged
+	}
+
+	return rr.Resource.Spec.Availability.PodDisruptionBudgets
+}
+
+// ChartChanged returns true if the given chart differs from the one recorded as installed.
+func (rr *ReconciliationRequest) ChartChanged(c *helme.Chart) bool {
+	if rr.InstalledChart == nil {
+		return true
+	}
+
+	return rr.InstalledChart.Name != c.Name() || rr.InstalledChart.Version != c.Version()
+}
+
+// chartName returns the name of the chart set in the chart spec, or the charts directory.
+func (rr *ReconciliationRequest) chartName() string {
+	if rr.Resource.Spec.Chart != nil {
+		return rr.Resource.Spec.Chart.Name
+	}
+
+	return rr.Helm.chartDir
 }
 
 func (rr *ReconciliationRequest) Chart(ctx context.Context) (*helme.Chart, error) {
@@ -53,9 +174,22 @@ func (rr *ReconciliationRequest) Chart(ctx context.Context) (*helme.Chart, error
 		cs.Name = rr.Resource.Spec.Chart.Name
 		cs.Repo = rr.Resource.Spec.Chart.Repo
 		cs.Version = rr.Resource.Spec.Chart.Version
+
+		if helm.IsVersionConstraint(cs.Version) {
+			v, err := rr.resolveChartVersion(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve chart version %s: %w", cs.Version, err)
+			}
+
+			cs.Version = v
+		}
+	}
+
+	if rr.Helm.chartConstraint == "" {
+		meta.RemoveStatusCondition(&rr.Resource.Status.Conditions, conditions.TypeChartResolved)
 	}
 
-	chartOpts, err := rr.computeChartOptions(ctx)
+	chartOpts, err := rr.computeChartOptions(ctx, cs)
 	if err != nil {
 		return nil, fmt.Errorf("unable to compute chart opetions: %w", err)
 	}
@@ -74,37 +208,246 @@ func (rr *ReconciliationRequest) Chart(ctx context.Context) (*helme.Chart, error
 	return rr.Helm.chart, nil
 }
 
-func (rr *ReconciliationRequest) computeChartOptions(ctx context.Context) ([]helme.ChartOption, error) {
-	chartOpts := make([]helme.ChartOption, 0)
-	chartOpts = append(chartOpts, helme.WithOverrides(rr.Helm.chartOverrides))
-	chartOpts = append(chartOpts, helme.WithValuesCustomizers(values.JQ(autoPullPolicySidecarInjector)))
-
-	if rr.Resource.Spec.Chart != nil && rr.Resource.Spec.Chart.Secret != "" {
-		s, err := rr.Client.CoreV1().Secrets(rr.Resource.Namespace).Get(
-			ctx,
-			rr.Resource.Spec.Chart.Secret,
-			metav1.GetOptions{},
-		)
-
-		switch {
-		case k8serrors.IsNotFound(err):
-			break
-		case err != nil:
-			return nil, fmt.Errorf("unable to fetch secret %s, %w", rr.Resource.Spec.Chart.Secret, err)
-		default:
-			if v, ok := s.Data[ChartRepoUsernameKey]; ok {
-				chartOpts = append(chartOpts, helme.WithUsername(string(v)))
-			}
+// Render renders the chart with the resource values and post-processes the result, in order:
+// placement, autoscaling, availability, patches, pod security, cluster profile and common
+// metadata. The result is cached for the duration of the reconciliation, a copy is returned
+// at each invocation so it can be freely modified.
+func (rr *ReconciliationRequest) Render(ctx context.Context) ([]unstructured.Unstructured, error) {
+	if rr.Helm.resources == nil {
+		c, err := rr.Chart(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := c.Render(ctx, rr.Resource.Name, rr.Resource.Namespace, int(rr.Resource.Generation), rr.Helm.ChartValues)
+		if err != nil {
+			//nolint:wrapcheck
+			return nil, err
+		}
 
-			if v, ok := s.Data[ChartRepoPasswordKey]; ok {
-				chartOpts = append(chartOpts, helme.WithPassword(string(v)))
+		unmatchedComponents, err := applyPlacement(rr.Resource.Spec.Placement, items)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err = applyAutoscaling(rr.Resource.Spec.Autoscaling, items)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err = applyAvailability(rr, items)
+		if err != nil {
+			return nil, err
+		}
+
+		unmatched, err := applyPatches(rr.Resource.Spec.Patches, items)
+		if err != nil {
+			return nil, err
+		}
+
+		if level, ok := rr.PodSecurityLevel(); ok {
+			for i := range items {
+				if err := podsecurity.Harden(&items[i], level); err != nil {
+					return nil, fmt.Errorf("cannot enforce pod security level %s on %s: %w", level, resources.Ref(&items[i]), err)
+				}
 			}
 		}
+
+		items, err = profileFor(rr.ClusterType).Apply(rr, items)
+		if err != nil {
+			return nil, fmt.Errorf("cannot apply %s cluster profile: %w", rr.ClusterType, err)
+		}
+
+		if err := applyCommonMetadata(rr, items); err != nil {
+			return nil, fmt.Errorf("cannot apply common metadata: %w", err)
+		}
+
+		rr.Helm.resources = items
+		rr.Helm.unmatchedPatches = unmatched
+		rr.Helm.unmatchedComponents = unmatchedComponents
+	}
+
+	answer := make([]unstructured.Unstructured, 0, len(rr.Helm.resources))
+
+	for i := range rr.Helm.resources {
+		answer = append(answer, *rr.Helm.resources[i].DeepCopy())
+	}
+
+	return answer, nil
+}
+
+func (rr *ReconciliationRequest) computeChartOptions(ctx context.Context, cs helme.ChartSpec) ([]helme.ChartOption, error) {
+	load := func(ctx context.Context) (*chart.Chart, error) {
+		return rr.Helm.charts.Load(ctx, rr, cs.Name, cs.Version)
+	}
+
+	chartOpts := make([]helme.ChartOption, 0)
+	chartOpts = append(chartOpts, helme.WithValuesCustomizers(valuesCustomizers(load, rr.Resource.Spec.ValuesTransforms)...))
+
+	// without a pod security level, the workloads are made to run as non-root users through
+	// the chart values, as they are not adjusted after rendering
+	if _, ok := rr.PodSecurityLevel(); !ok {
+		chartOpts = append(chartOpts, helme.WithOverrides(runAsNonRootOverrides()))
+	}
+
+	ro, err := rr.repositoryOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if ro.Username != "" {
+		chartOpts = append(chartOpts, helme.WithUsername(ro.Username))
+	}
+
+	if ro.Password != "" {
+		chartOpts = append(chartOpts, helme.WithPassword(ro.Password))
 	}
 
 	return chartOpts, nil
 }
 
+// repositoryOptions computes the options to access the chart repository, including the
+// credentials stored in the secret referenced by the chart spec, if any.
+func (rr *ReconciliationRequest) repositoryOptions(ctx context.Context) (helm.RepositoryOptions, error) {
+	ro := helm.RepositoryOptions{}
+
+	if rr.Resource.Spec.Chart == nil || rr.Resource.Spec.Chart.Secret == "" {
+		return ro, nil
+	}
+
+	// when rendering offline there is no cluster to read the secret from
+	if rr.Client == nil {
+		return ro, nil
+	}
+
+	s, err := rr.Client.CoreV1().Secrets(rr.Resource.Namespace).Get(
+		ctx,
+		rr.Resource.Spec.Chart.Secret,
+		metav1.GetOptions{},
+	)
+
+	switch {
+	case k8serrors.IsNotFound(err):
+		break
+	case err != nil:
+		return ro, fmt.Errorf("unable to fetch secret %s, %w", rr.Resource.Spec.Chart.Secret, err)
+	default:
+		if v, ok := s.Data[ChartRepoUsernameKey]; ok {
+			ro.Username = string(v)
+		}
+
+		if v, ok := s.Data[ChartRepoPasswordKey]; ok {
+			ro.Password = string(v)
+		}
+	}
+
+	return ro, nil
+}
+
+// resolveChartVersion resolves the version constraint set in the chart spec against the
+// repository. The version previously resolved is reused until the resolve interval has
+// elapsed, then the repository is queried again so new matching versions, such as new
+// patch releases, are picked up and applied automatically.
+//
+// When the repository cannot be queried, the installed chart is kept as long as it still
+// satisfies the constraint, and the failure is reported by the ChartResolved condition.
+func (rr *ReconciliationRequest) resolveChartVersion(ctx context.Context) (string, error) {
+	spec := rr.Resource.Spec.Chart
+
+	interval := helm.DefaultResolveInterval
+	if spec.ResolveInterval != nil && spec.ResolveInterval.Duration > 0 {
+		interval = spec.ResolveInterval.Duration
+	}
+
+	rr.Helm.chartConstraint = spec.Version
+
+	installed := rr.InstalledChart
+	if installed != nil && installed.Constraint == spec.Version && installed.ResolvedAt != nil {
+		elapsed := time.Since(installed.ResolvedAt.Time)
+
+		if elapsed < interval && helm.SatisfiesConstraint(installed.Version, spec.Version) {
+			rr.Helm.chartResolvedAt = installed.ResolvedAt
+			rr.RequeueAfter(interval - elapsed)
+
+			return installed.Version, nil
+		}
+	}
+
+	// the resolved version is not recorded in the status until it is installed
+	if r, ok := rr.Helm.charts.Resolved(spec.Repo, spec.Name, spec.Version); ok {
+		elapsed := time.Since(r.resolvedAt.Time)
+
+		if elapsed < interval {
+			rr.Helm.chartResolvedAt = pointer.Any(r.resolvedAt)
+			rr.RequeueAfter(interval - elapsed)
+
+			return r.version, nil
+		}
+	}
+
+	v, err := rr.queryChartVersion(ctx)
+	if err != nil {
+		if installed == nil || installed.Repo != spec.Repo || !helm.SatisfiesConstraint(installed.Version, spec.Version) {
+			return "", err
+		}
+
+		meta.SetStatusCondition(&rr.Resource.Status.Conditions, metav1.Condition{
+			Type:               conditions.TypeChartResolved,
+			Status:             metav1.ConditionFalse,
+			Reason:             conditions.ReasonRepositoryUnavailable,
+			Message:            fmt.Sprintf("keeping the installed version %s: %v", installed.Version, err),
+			ObservedGeneration: rr.Resource.Generation,
+		})
+
+		rr.Helm.chartResolvedAt = installed.ResolvedAt
+		rr.RequeueAfter(interval)
+
+		return installed.Version, nil
+	}
+
+	meta.SetStatusCondition(&rr.Resource.Status.Conditions, metav1.Condition{
+		Type:               conditions.TypeChartResolved,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditions.ReasonChartResolved,
+		Message:            fmt.Sprintf("version constraint %s resolved to %s", spec.Version, v),
+		ObservedGeneration: rr.Resource.Generation,
+	})
+
+	rr.Helm.chartResolvedAt = pointer.Any(metav1.Now())
+	rr.Helm.charts.Resolve(spec.Repo, spec.Name, spec.Version, resolvedVersion{
+		version:    v,
+		resolvedAt: *rr.Helm.chartResolvedAt,
+	})
+
+	rr.RequeueAfter(interval)
+
+	return v, nil
+}
+
+// queryChartVersion queries the repository for the latest version of the chart matching the
+// version constraint set in the chart spec.
+func (rr *ReconciliationRequest) queryChartVersion(ctx context.Context) (string, error) {
+	spec := rr.Resource.Spec.Chart
+
+	ro, err := rr.repositoryOptions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	versions, err := helm.ChartVersions(spec.Repo, spec.Name, ro)
+	if err != nil {
+		return "", fmt.Errorf("unable to list chart versions: %w", err)
+	}
+
+	v, err := helm.ResolveVersion(versions, spec.Version)
+	if err != nil {
+		//nolint:wrapcheck
+		return "", err
+	}
+
+	return v.Original(), nil
+}
+
 type Action interface {
 	Configure(ctx context.Context, c *client.Client, b *builder.Builder) (*builder.Builder, error)
 	Run(ctx context.Context, rc *ReconciliationRequest) error
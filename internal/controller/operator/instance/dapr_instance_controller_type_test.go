@@ -0,0 +1,163 @@
+package instance_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+
+	. "github.com/onsi/gomega"
+)
+
+const chartIndex = `
+apiVersion: v1
+entries:
+  dapr:
+  - apiVersion: v2
+    name: dapr
+    version: 1.16.1
+    urls: [ dapr-1.16.1.tgz ]
+  - apiVersion: v2
+    name: dapr
+    version: 1.16.0
+    urls: [ dapr-1.16.0.tgz ]
+`
+
+// chartRepository returns an HTTP chart repository, and the number of times its index has
+// been downloaded.
+func chartRepository(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	t.Setenv("HELM_REPOSITORY_CACHE", t.TempDir())
+
+	queries := atomic.Int32{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/index.yaml" {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		queries.Add(1)
+
+		_, _ = w.Write([]byte(chartIndex))
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv, &queries
+}
+
+func chartRequest(repo string, constraint string, installed *daprApi.ChartMeta) *instance.ReconciliationRequest {
+	return &instance.ReconciliationRequest{
+		Resource: &daprApi.DaprInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+			Spec: daprApi.DaprInstanceSpec{
+				Chart: &daprApi.ChartSpec{
+					Repo:    repo,
+					Name:    "dapr",
+					Version: constraint,
+				},
+			},
+		},
+		InstalledChart: installed,
+	}
+}
+
+func TestResolveChartVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	srv, queries := chartRepository(t)
+	charts := instance.NewHelmCharts()
+
+	rr := chartRequest(srv.URL, "~1.16.0", nil)
+
+	v, err := instance.ResolveChartVersion(context.Background(), rr, charts)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(v).To(Equal("1.16.1"))
+	g.Expect(queries.Load()).To(Equal(int32(1)))
+
+	c := meta.FindStatusCondition(rr.Resource.Status.Conditions, conditions.TypeChartResolved)
+	g.Expect(c).ToNot(BeNil())
+	g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+
+	// the resolved version is not yet recorded in the status, as it happens while the
+	// changes are held, the repository is not queried again before the resolve interval
+	rr = chartRequest(srv.URL, "~1.16.0", nil)
+
+	v, err = instance.ResolveChartVersion(context.Background(), rr, charts)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(v).To(Equal("1.16.1"))
+	g.Expect(queries.Load()).To(Equal(int32(1)))
+
+	// a different constraint is resolved on its own
+	rr = chartRequest(srv.URL, "1.16.0 - 1.16.0", nil)
+
+	v, err = instance.ResolveChartVersion(context.Background(), rr, charts)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(v).To(Equal("1.16.0"))
+	g.Expect(queries.Load()).To(Equal(int32(2)))
+}
+
+func TestResolveChartVersionUnreachableRepository(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	t.Setenv("HELM_REPOSITORY_CACHE", t.TempDir())
+
+	tests := []struct {
+		name      string
+		installed *daprApi.ChartMeta
+		expected  string
+	}{
+		{
+			name:      "installed chart satisfying the constraint",
+			installed: &daprApi.ChartMeta{Repo: srv.URL, Name: "dapr", Version: "1.16.0"},
+			expected:  "1.16.0",
+		},
+		{
+			name:      "installed chart not satisfying the constraint",
+			installed: &daprApi.ChartMeta{Repo: srv.URL, Name: "dapr", Version: "1.15.3"},
+		},
+		{
+			name:      "installed chart from another repository",
+			installed: &daprApi.ChartMeta{Repo: "https://charts.example.com", Name: "dapr", Version: "1.16.0"},
+		},
+		{
+			name:      "not installed",
+			installed: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rr := chartRequest(srv.URL, "~1.16.0", tt.installed)
+
+			v, err := instance.ResolveChartVersion(context.Background(), rr, instance.NewHelmCharts())
+			if tt.expected == "" {
+				g.Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(v).To(Equal(tt.expected))
+
+			c := meta.FindStatusCondition(rr.Resource.Status.Conditions, conditions.TypeChartResolved)
+			g.Expect(c).ToNot(BeNil())
+			g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(c.Reason).To(Equal(conditions.ReasonRepositoryUnavailable))
+		})
+	}
+}
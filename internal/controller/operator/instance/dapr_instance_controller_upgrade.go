@@ -0,0 +1,346 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+// upgradeSteps defines the order in which the control plane workloads are rolled out
+// when the chart version changes, as recommended by the Dapr upgrade guide:
+//
+// - https://docs.dapr.io/operations/hosting/kubernetes/kubernetes-upgrade/
+//
+// Workloads that do not belong to any step are rolled out last.
+var upgradeSteps = []upgradeStep{
+	{Name: "sentry", Workloads: []string{"dapr-sentry"}},
+	{Name: "operator", Workloads: []string{"dapr-operator"}},
+	{Name: "placement", Workloads: []string{"dapr-placement-server"}},
+	{Name: "scheduler", Workloads: []string{"dapr-scheduler-server"}},
+	{Name: "sidecar-injector", Workloads: []string{"dapr-sidecar-injector"}},
+}
+
+type upgradeStep struct {
+	Name      string
+	Workloads []string
+}
+
+type upgradeStepObjects struct {
+	Name    string
+	Objects []unstructured.Unstructured
+}
+
+func newUpgradeOrchestrator(l logr.Logger) *upgradeOrchestrator {
+	return &upgradeOrchestrator{
+		l: l.WithName("upgrade"),
+	}
+}
+
+// upgradeOrchestrator rolls the control plane workloads out in a defined order when the
+// chart version changes, waiting for each step to become available before moving to
+// the next one.
+//
+// The orchestrator does not keep any state, an upgrade is considered in progress if any
+// of the live workloads has been rendered out of a different chart version or if the
+// Progressing condition is still set. The reconciliation is re-triggered by the status
+// changes of the watched workloads.
+type upgradeOrchestrator struct {
+	l logr.Logger
+}
+
+func (o *upgradeOrchestrator) Run(
+	ctx context.Context,
+	rc *ReconciliationRequest,
+	version string,
+	workloads []unstructured.Unstructured,
+	apply func(*unstructured.Unstructured) error,
+) error {
+	upgrading, err := o.upgrading(ctx, rc, version, workloads)
+	if err != nil {
+		return err
+	}
+
+	if !upgrading {
+		for i := range workloads {
+			if err := apply(&workloads[i]); err != nil {
+				return err
+			}
+		}
+
+		if meta.FindStatusCondition(rc.Resource.Status.Conditions, conditions.TypeProgressing) == nil {
+			meta.SetStatusCondition(&rc.Resource.Status.Conditions, metav1.Condition{
+				Type:               conditions.TypeProgressing,
+				Status:             metav1.ConditionFalse,
+				Reason:             conditions.ReasonUpToDate,
+				Message:            "control plane at version " + version,
+				ObservedGeneration: rc.Resource.Generation,
+			})
+		}
+
+		return nil
+	}
+
+	steps := o.steps(workloads)
+
+	for i, step := range steps {
+		for j := range step.Objects {
+			if err := apply(&step.Objects[j]); err != nil {
+				return err
+			}
+		}
+
+		available, err := o.available(ctx, rc, step.Objects)
+		if err != nil {
+			return err
+		}
+
+		if available {
+			continue
+		}
+
+		message := fmt.Sprintf("upgrading to %s, step %d/%d: waiting for %s to become available",
+			version,
+			i+1,
+			len(steps),
+			step.Name)
+
+		o.l.Info("upgrade", "version", version, "step", step.Name)
+
+		c := meta.FindStatusCondition(rc.Resource.Status.Conditions, conditions.TypeProgressing)
+		if c == nil || c.Message != message {
+			rc.Reconciler.Event(
+				rc.Resource,
+				corev1.EventTypeNormal,
+				"UpgradeStep",
+				message,
+			)
+		}
+
+		meta.SetStatusCondition(&rc.Resource.Status.Conditions, metav1.Condition{
+			Type:               conditions.TypeProgressing,
+			Status:             metav1.ConditionTrue,
+			Reason:             conditions.ReasonUpgrading,
+			Message:            message,
+			ObservedGeneration: rc.Resource.Generation,
+		})
+
+		return nil
+	}
+
+	rc.Reconciler.Event(
+		rc.Resource,
+		corev1.EventTypeNormal,
+		"UpgradeCompleted",
+		"upgrade to "+version+" completed",
+	)
+
+	meta.SetStatusCondition(&rc.Resource.Status.Conditions, metav1.Condition{
+		Type:               conditions.TypeProgressing,
+		Status:             metav1.ConditionFalse,
+		Reason:             conditions.ReasonUpgradeCompleted,
+		Message:            "upgrade to " + version + " completed",
+		ObservedGeneration: rc.Resource.Generation,
+	})
+
+	return nil
+}
+
+// upgrading determines if an upgrade is in progress, which happens when the upgrade has not
+// yet been marked as completed or when any of the live workloads has been rendered out of a
+// chart version different from the given one.
+func (o *upgradeOrchestrator) upgrading(
+	ctx context.Context,
+	rc *ReconciliationRequest,
+	version string,
+	workloads []unstructured.Unstructured,
+) (bool, error) {
+	if meta.IsStatusConditionTrue(rc.Resource.Status.Conditions, conditions.TypeProgressing) {
+		return true, nil
+	}
+
+	for i := range workloads {
+		live, err := o.live(ctx, rc, &workloads[i])
+		if err != nil {
+			return false, err
+		}
+
+		if live == nil {
+			continue
+		}
+
+		v := resources.Label(live, helm.ReleaseVersion)
+		if v != "" && v != version {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// steps groups the workloads according to the upgradeSteps order, any workload not
+// belonging to a known step is added to a trailing step.
+func (o *upgradeOrchestrator) steps(workloads []unstructured.Unstructured) []upgradeStepObjects {
+	answer := make([]upgradeStepObjects, 0, len(upgradeSteps)+1)
+	known := make(map[string]struct{})
+
+	for _, s := range upgradeSteps {
+		step := upgradeStepObjects{Name: s.Name}
+
+		for i := range workloads {
+			if slices.Contains(s.Workloads, workloads[i].GetName()) {
+				step.Objects = append(step.Objects, workloads[i])
+				known[workloads[i].GetName()] = struct{}{}
+			}
+		}
+
+		if len(step.Objects) > 0 {
+			answer = append(answer, step)
+		}
+	}
+
+	others := upgradeStepObjects{Name: "others"}
+
+	for i := range workloads {
+		if _, ok := known[workloads[i].GetName()]; !ok {
+			others.Objects = append(others.Objects, workloads[i])
+		}
+	}
+
+	if len(others.Objects) > 0 {
+		answer = append(answer, others)
+	}
+
+	return answer
+}
+
+func (o *upgradeOrchestrator) available(ctx context.Context, rc *ReconciliationRequest, objects []unstructured.Unstructured) (bool, error) {
+	for i := range objects {
+		live, err := o.live(ctx, rc, &objects[i])
+		if err != nil {
+			return false, err
+		}
+
+		if live == nil {
+			return false, nil
+		}
+
+		ok, err := workloadAvailable(live)
+		if err != nil {
+			return false, fmt.Errorf("cannot determine availability of %s: %w", resources.Ref(live), err)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (o *upgradeOrchestrator) live(ctx context.Context, rc *ReconciliationRequest, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	dc, err := rc.Client.Dynamic(rc.Resource.Namespace, obj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create dynamic client: %w", err)
+	}
+
+	live, err := dc.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get object %s: %w", resources.Ref(obj), err)
+	}
+
+	return live, nil
+}
+
+func isWorkload(gvk schema.GroupVersionKind) bool {
+	if gvk.Group == "apps" && gvk.Version == "v1" && gvk.Kind == "Deployment" {
+		return true
+	}
+
+	if gvk.Group == "apps" && gvk.Version == "v1" && gvk.Kind == "StatefulSet" {
+		return true
+	}
+
+	return false
+}
+
+// workloadsDigest computes a digest of the control plane workloads out of the rendered
+// objects, it must be computed before any release specific label is added.
+func workloadsDigest(items []unstructured.Unstructured) (string, error) {
+	workloads := make([]unstructured.Unstructured, 0)
+
+	for i := range items {
+		if isWorkload(items[i].GroupVersionKind()) {
+			workloads = append(workloads, items[i])
+		}
+	}
+
+	//nolint:wrapcheck
+	return resources.Digest(workloads...)
+}
+
+// workloadAvailable checks if the latest spec of a Deployment or StatefulSet has been fully
+// rolled out and all the replicas are available.
+func workloadAvailable(obj *unstructured.Unstructured) (bool, error) {
+	switch obj.GroupVersionKind().Kind {
+	case "Deployment":
+		d := appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &d); err != nil {
+			return false, fmt.Errorf("cannot convert to Deployment: %w", err)
+		}
+
+		replicas := int32(1)
+		if d.Spec.Replicas != nil {
+			replicas = *d.Spec.Replicas
+		}
+
+		if d.Status.ObservedGeneration < d.Generation {
+			return false, nil
+		}
+
+		if conditions.ConditionStatus(&d, appsv1.DeploymentAvailable) != corev1.ConditionTrue {
+			return false, nil
+		}
+
+		return d.Status.UpdatedReplicas == replicas && d.Status.AvailableReplicas == replicas, nil
+	case "StatefulSet":
+		s := appsv1.StatefulSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &s); err != nil {
+			return false, fmt.Errorf("cannot convert to StatefulSet: %w", err)
+		}
+
+		replicas := int32(1)
+		if s.Spec.Replicas != nil {
+			replicas = *s.Spec.Replicas
+		}
+
+		if s.Status.ObservedGeneration < s.Generation {
+			return false, nil
+		}
+
+		if s.Status.UpdateRevision != "" && s.Status.CurrentRevision != s.Status.UpdateRevision {
+			return false, nil
+		}
+
+		return s.Status.UpdatedReplicas == replicas && s.Status.ReadyReplicas == replicas, nil
+	default:
+		return true, nil
+	}
+}
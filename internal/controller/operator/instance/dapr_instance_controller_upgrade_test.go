@@ -0,0 +1,411 @@
+package instance_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+func workloadObject(kind string, name string) unstructured.Unstructured {
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("dapr-system")
+
+	return obj
+}
+
+func TestUpgradeOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		workloads []string
+		expected  []string
+	}{
+		{
+			name: "all the steps",
+			workloads: []string{
+				"dapr-sidecar-injector",
+				"dapr-extra",
+				"dapr-scheduler-server",
+				"dapr-operator",
+				"dapr-placement-server",
+				"dapr-sentry",
+			},
+			expected: []string{"sentry", "operator", "placement", "scheduler", "sidecar-injector", "others"},
+		},
+		{
+			name:      "missing steps",
+			workloads: []string{"dapr-sidecar-injector", "dapr-sentry"},
+			expected:  []string{"sentry", "sidecar-injector"},
+		},
+		{
+			name:      "none",
+			workloads: []string{},
+			expected:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			workloads := make([]unstructured.Unstructured, 0, len(tt.workloads))
+			for _, name := range tt.workloads {
+				workloads = append(workloads, workloadObject("Deployment", name))
+			}
+
+			steps := instance.UpgradeOrder(workloads)
+
+			names := make([]string, 0, len(steps))
+			for _, s := range steps {
+				g.Expect(s.Objects).To(HaveLen(1))
+
+				names = append(names, s.Name)
+			}
+
+			g.Expect(names).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestUpgrading(t *testing.T) {
+	const version = "1.16.1"
+
+	labeled := func(kind string, name string, v string) *unstructured.Unstructured {
+		obj := workloadObject(kind, name)
+		if v != "" {
+			obj.SetLabels(map[string]string{helm.ReleaseVersion: v})
+		}
+
+		return &obj
+	}
+
+	progressing := func(status metav1.ConditionStatus) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:   conditions.TypeProgressing,
+			Status: status,
+			Reason: conditions.ReasonUpgrading,
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		live       []runtime.Object
+		conditions []metav1.Condition
+		expected   bool
+	}{
+		{
+			name:     "first install",
+			live:     nil,
+			expected: false,
+		},
+		{
+			name: "same version",
+			live: []runtime.Object{
+				labeled("Deployment", "dapr-sentry", version),
+				labeled("StatefulSet", "dapr-scheduler-server", version),
+			},
+			expected: false,
+		},
+		{
+			name: "deployment at a different version",
+			live: []runtime.Object{
+				labeled("Deployment", "dapr-sentry", "1.15.4"),
+				labeled("StatefulSet", "dapr-scheduler-server", version),
+			},
+			expected: true,
+		},
+		{
+			name: "statefulset at a different version",
+			live: []runtime.Object{
+				labeled("Deployment", "dapr-sentry", version),
+				labeled("StatefulSet", "dapr-scheduler-server", "1.15.4"),
+			},
+			expected: true,
+		},
+		{
+			name: "not labeled",
+			live: []runtime.Object{
+				labeled("Deployment", "dapr-sentry", ""),
+			},
+			expected: false,
+		},
+		{
+			name: "upgrade in progress",
+			live: []runtime.Object{
+				labeled("Deployment", "dapr-sentry", version),
+			},
+			conditions: progressing(metav1.ConditionTrue),
+			expected:   true,
+		},
+		{
+			name: "upgrade completed",
+			live: []runtime.Object{
+				labeled("Deployment", "dapr-sentry", version),
+			},
+			conditions: progressing(metav1.ConditionFalse),
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rc := &instance.ReconciliationRequest{
+				Client: newClient(tt.live...),
+				Resource: &daprApi.DaprInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "dapr-instance", Namespace: "dapr-system"},
+					Status: daprApi.DaprInstanceStatus{
+						Status: daprApi.Status{Conditions: tt.conditions},
+					},
+				},
+			}
+
+			workloads := []unstructured.Unstructured{
+				workloadObject("Deployment", "dapr-sentry"),
+				workloadObject("StatefulSet", "dapr-scheduler-server"),
+			}
+
+			upgrading, err := instance.Upgrading(context.Background(), rc, version, workloads)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(upgrading).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestWorkloadAvailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		object   string
+		expected bool
+	}{
+		{
+			name: "deployment available",
+			object: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+  conditions:
+  - type: Available
+    status: "True"
+`,
+			expected: true,
+		},
+		{
+			name: "deployment with default replicas",
+			object: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  generation: 1
+status:
+  observedGeneration: 1
+  updatedReplicas: 1
+  availableReplicas: 1
+  conditions:
+  - type: Available
+    status: "True"
+`,
+			expected: true,
+		},
+		{
+			name: "deployment spec not observed",
+			object: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  generation: 3
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+  conditions:
+  - type: Available
+    status: "True"
+`,
+			expected: false,
+		},
+		{
+			name: "deployment not available",
+			object: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+  conditions:
+  - type: Available
+    status: "False"
+`,
+			expected: false,
+		},
+		{
+			name: "deployment rolling out",
+			object: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  updatedReplicas: 1
+  availableReplicas: 2
+  conditions:
+  - type: Available
+    status: "True"
+`,
+			expected: false,
+		},
+		{
+			name: "deployment replicas not available",
+			object: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-sentry
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  updatedReplicas: 2
+  availableReplicas: 1
+  conditions:
+  - type: Available
+    status: "True"
+`,
+			expected: false,
+		},
+		{
+			name: "statefulset available",
+			object: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: dapr-scheduler-server
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  currentRevision: dapr-scheduler-server-2
+  updateRevision: dapr-scheduler-server-2
+  updatedReplicas: 3
+  readyReplicas: 3
+`,
+			expected: true,
+		},
+		{
+			name: "statefulset spec not observed",
+			object: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: dapr-scheduler-server
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 1
+  currentRevision: dapr-scheduler-server-2
+  updateRevision: dapr-scheduler-server-2
+  updatedReplicas: 3
+  readyReplicas: 3
+`,
+			expected: false,
+		},
+		{
+			name: "statefulset rolling out",
+			object: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: dapr-scheduler-server
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  currentRevision: dapr-scheduler-server-1
+  updateRevision: dapr-scheduler-server-2
+  updatedReplicas: 1
+  readyReplicas: 3
+`,
+			expected: false,
+		},
+		{
+			name: "statefulset replicas not ready",
+			object: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: dapr-scheduler-server
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  currentRevision: dapr-scheduler-server-2
+  updateRevision: dapr-scheduler-server-2
+  updatedReplicas: 3
+  readyReplicas: 2
+`,
+			expected: false,
+		},
+		{
+			name: "other kinds",
+			object: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: dapr-api
+`,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := object(t, tt.object)
+
+			available, err := instance.WorkloadAvailable(&obj)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(available).To(Equal(tt.expected))
+		})
+	}
+}
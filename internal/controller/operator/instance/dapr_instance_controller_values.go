@@ -0,0 +1,204 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"helm.sh/helm/v3/pkg/chart"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+)
+
+// runAsNonRootOverrides returns the values that make the control plane workloads run as
+// non-root users.
+func runAsNonRootOverrides() map[string]interface{} {
+	return map[string]interface{}{
+		"dapr_operator":         map[string]interface{}{"runAsNonRoot": true},
+		"dapr_placement":        map[string]interface{}{"runAsNonRoot": true},
+		"dapr_sentry":           map[string]interface{}{"runAsNonRoot": true},
+		"dapr_dashboard":        map[string]interface{}{"runAsNonRoot": true},
+		"dapr_sidecar_injector": map[string]interface{}{"runAsNonRoot": true},
+	}
+}
+
+// curatedValuesSchema describes the values the operator relies on or manipulates, either
+// through the chart overrides or through the values customizers, so that values that would
+// make them fail are reported before rendering.
+const curatedValuesSchema = `
+{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "global": {
+      "type": "object",
+      "properties": {
+        "k8sLabels": {
+          "type": "object",
+          "additionalProperties": { "type": "string" }
+        }
+      }
+    },
+    "dapr_operator": { "type": "object" },
+    "dapr_placement": { "type": "object" },
+    "dapr_sentry": { "type": "object" },
+    "dapr_dashboard": { "type": "object" },
+    "dapr_sidecar_injector": {
+      "type": "object",
+      "properties": {
+        "image": {
+          "type": "object",
+          "properties": {
+            "name": { "type": "string" }
+          }
+        },
+        "sidecarImagePullPolicy": {
+          "enum": [ "Always", "IfNotPresent", "Never" ]
+        }
+      }
+    }
+  }
+}
+`
+
+func newHelmCharts() *helmCharts {
+	return &helmCharts{
+		charts:   make(map[string]*chart.Chart),
+		resolved: make(map[string]resolvedVersion),
+	}
+}
+
+// helmCharts holds the charts that have been loaded to inspect their default values and
+// schemas, a chart version being immutable, each chart is loaded only once.
+//
+// It also holds the last resolution of each chart version constraint, so the repository is
+// not queried again before the resolve interval has elapsed, including when the resolved
+// version is not recorded in the status, i.e. while the changes are held.
+type helmCharts struct {
+	lock     sync.Mutex
+	charts   map[string]*chart.Chart
+	resolved map[string]resolvedVersion
+}
+
+type resolvedVersion struct {
+	version    string
+	resolvedAt metav1.Time
+}
+
+// Resolved returns the last resolution of the given version constraint of the chart.
+func (h *helmCharts) Resolved(repo string, name string, constraint string) (resolvedVersion, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	r, ok := h.resolved[repo+"/"+name+"@"+constraint]
+
+	return r, ok
+}
+
+// Resolve records the resolution of the given version constraint of the chart.
+func (h *helmCharts) Resolve(repo string, name string, constraint string, r resolvedVersion) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.resolved[repo+"/"+name+"@"+constraint] = r
+}
+
+// Load loads the chart with the given name and version, either from the charts directory
+// or from the repository of the chart spec of the given request.
+func (h *helmCharts) Load(ctx context.Context, rr *ReconciliationRequest, name string, version string) (*chart.Chart, error) {
+	repo := ""
+
+	if rr.Resource.Spec.Chart != nil {
+		repo = rr.Resource.Spec.Chart.Repo
+	}
+
+	key := repo + "/" + name + ":" + version
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if c, ok := h.charts[key]; ok {
+		return c, nil
+	}
+
+	ro, err := rr.repositoryOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := helm.LoadChart(repo, name, version, ro)
+	if err != nil {
+		//nolint:wrapcheck
+		return nil, err
+	}
+
+	h.charts[key] = c
+
+	return c, nil
+}
+
+func newValuesSchemas() *valuesSchemas {
+	return &valuesSchemas{
+		validators: make(map[*chart.Chart]*helm.ValuesValidator),
+	}
+}
+
+// valuesSchemas holds the values validators of the charts loaded through helmCharts, the
+// validator of each chart is computed only once.
+type valuesSchemas struct {
+	lock       sync.Mutex
+	validators map[*chart.Chart]*helm.ValuesValidator
+}
+
+// Validate validates the chart values of the given request, once processed by the values
+// customizers as when rendering, against the values.schema.json
+// of the chart and of its subcharts, combined with the curated schema. It returns the top
+// level keys that are unknown to the chart.
+func (s *valuesSchemas) Validate(ctx context.Context, rr *ReconciliationRequest) ([]string, error) {
+	c, err := rr.Chart(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	hc, err := rr.Helm.charts.Load(ctx, rr, rr.chartName(), c.Version())
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.validator(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	load := func(context.Context) (*chart.Chart, error) {
+		return hc, nil
+	}
+
+	values, err := transformValues(ctx, valuesCustomizers(load, rr.Resource.Spec.ValuesTransforms), rr.Helm.ChartValues)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:wrapcheck
+	return v.Validate(values)
+}
+
+func (s *valuesSchemas) validator(hc *chart.Chart) (*helm.ValuesValidator, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.validators[hc]; ok {
+		return v, nil
+	}
+
+	v, err := helm.NewValuesValidator(hc, []byte(curatedValuesSchema))
+	if err != nil {
+		//nolint:wrapcheck
+		return nil, err
+	}
+
+	s.validators[hc] = v
+
+	return v, nil
+}
@@ -0,0 +1,140 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/openshift"
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+)
+
+var ErrClientRequired = errors.New("a client is required")
+
+func NewRenderer(c *client.Client, o helm.Options) *Renderer {
+	return &Renderer{
+		client:  c,
+		options: o,
+		engine:  helme.New(),
+		charts:  newHelmCharts(),
+		planner: newPlanner(),
+	}
+}
+
+// Renderer renders the resources the controller would apply for a DaprInstance outside of
+// the reconciliation loop, using the same chart loading, overrides, values customizers and
+// release labels.
+//
+// The client is optional, when it is not set the resources are rendered offline, as such
+// the chart repository credentials referenced by the chart spec are not resolved and the
+// owner references, which depend on the scope of the resources, are not set.
+//
+// The cluster profile applied to the rendered resources is the one of ClusterType, if not
+// set it is detected from the cluster or, when rendering offline, it defaults to Vanilla.
+type Renderer struct {
+	ClusterType controller.ClusterType
+
+	client  *client.Client
+	options helm.Options
+	engine  *helme.Instance
+	charts  *helmCharts
+	planner *planner
+}
+
+func (r *Renderer) Render(ctx context.Context, res *daprApi.DaprInstance) ([]unstructured.Unstructured, error) {
+	rr, err := r.reconciliationRequest(res)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := rr.Chart(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chart: %w", err)
+	}
+
+	items, err := rr.Render(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render a chart: %w", err)
+	}
+
+	sortResources(items)
+
+	for i := range items {
+		resources.Labels(&items[i], releaseLabels(&rr, c.Version()))
+
+		if r.client == nil {
+			continue
+		}
+
+		dc, err := r.client.Dynamic(res.Namespace, &items[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot create dynamic client: %w", err)
+		}
+
+		if _, ok := dc.(*client.NamespacedResource); ok {
+			if res.UID != "" {
+				items[i].SetOwnerReferences(resources.OwnerReferences(res))
+			}
+
+			items[i].SetNamespace(res.Namespace)
+		}
+	}
+
+	return items, nil
+}
+
+// Changes computes the changes the controller would apply to the live objects for the given
+// DaprInstance, including the objects that would be pruned. It requires a client.
+func (r *Renderer) Changes(ctx context.Context, res *daprApi.DaprInstance) ([]Change, error) {
+	if r.client == nil {
+		return nil, ErrClientRequired
+	}
+
+	rr, err := r.reconciliationRequest(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.planner.Changes(ctx, &rr)
+}
+
+func (r *Renderer) reconciliationRequest(res *daprApi.DaprInstance) (ReconciliationRequest, error) {
+	rr, err := newReconciliationRequest(r.client, r.engine, r.charts, r.options, res)
+	if err != nil {
+		return ReconciliationRequest{}, err
+	}
+
+	rr.ClusterType = r.ClusterType
+
+	if rr.ClusterType != "" {
+		return rr, nil
+	}
+
+	rr.ClusterType = controller.ClusterTypeVanilla
+
+	if r.client == nil {
+		return rr, nil
+	}
+
+	isOpenshift, err := openshift.IsOpenShift(r.client.Discovery)
+	if err != nil {
+		//nolint:wrapcheck
+		return ReconciliationRequest{}, err
+	}
+
+	if isOpenshift {
+		rr.ClusterType = controller.ClusterTypeOpenShift
+	}
+
+	// cache the detected type, so the cluster is not queried at each invocation
+	r.ClusterType = rr.ClusterType
+
+	return rr, nil
+}
@@ -0,0 +1,155 @@
+package instance_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+const chartsDir = "../../../../" + helm.ChartsDir
+
+func TestRenderOpenShiftShippedChart(t *testing.T) {
+	g := NewWithT(t)
+
+	r := instance.NewRenderer(nil, helm.Options{ChartsDir: chartsDir})
+	r.ClusterType = controller.ClusterTypeOpenShift
+
+	items, err := r.Render(t.Context(), &daprApi.DaprInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.DaprInstanceResourceName, Namespace: "dapr-system"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// the sidecar injector of the shipped chart gets its certificate from sentry, it does not
+	// mount any Secret the service CA could generate
+	injector := find(items, "Deployment", instance.DeploymentSidecarInjector)
+	g.Expect(injector).ToNot(BeNil())
+
+	volumes, _, err := unstructured.NestedSlice(injector.Object, "spec", "template", "spec", "volumes")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, v := range volumes {
+		g.Expect(v).ToNot(HaveKey("secret"))
+	}
+
+	// as such, the service CA is not used and the webhook configuration stays install-only
+	svc := find(items, "Service", instance.ServiceSidecarInjector)
+	g.Expect(svc).ToNot(BeNil())
+	g.Expect(svc.GetAnnotations()).ToNot(HaveKey(instance.OpenShiftServingCertAnnotation))
+
+	webhook := find(items, "MutatingWebhookConfiguration", instance.WebhookSidecarInjector)
+	g.Expect(webhook).ToNot(BeNil())
+	g.Expect(webhook.GetAnnotations()).ToNot(HaveKey(instance.OpenShiftInjectCABundleAnnotation))
+	g.Expect(instance.ProfileFor(controller.ClusterTypeOpenShift).Reconcilable(webhook)).To(BeFalse())
+
+	for i := range items {
+		if items[i].GetKind() == "Service" {
+			g.Expect(items[i].GetAnnotations()).ToNot(HaveKey(instance.OpenShiftServingCertAnnotation), items[i].GetName())
+		}
+	}
+}
+
+func TestRenderPodSecurity(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   daprApi.PodSecurityLevel
+		seccomp bool
+	}{
+		{name: "not set", level: "", seccomp: false},
+		{name: "baseline", level: daprApi.PodSecurityLevelBaseline, seccomp: false},
+		{name: "restricted", level: daprApi.PodSecurityLevelRestricted, seccomp: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := instance.NewRenderer(nil, helm.Options{ChartsDir: chartsDir})
+			r.ClusterType = controller.ClusterTypeVanilla
+
+			res := daprInstance(1, `{"dapr_operator":{"runAsNonRoot":false}}`)
+			if tt.level != "" {
+				res.Spec.Security = &daprApi.SecuritySpec{PodSecurity: tt.level}
+			}
+
+			items, err := r.Render(t.Context(), res)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			operator := find(items, "Deployment", "dapr-operator")
+			g.Expect(operator).ToNot(BeNil())
+
+			// the RuntimeDefault seccomp profile is only set at the restricted level
+			_, seccomp, err := unstructured.NestedMap(operator.Object, "spec", "template", "spec", "securityContext", "seccompProfile")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(seccomp).To(Equal(tt.seccomp))
+
+			containers, _, err := unstructured.NestedSlice(operator.Object, "spec", "template", "spec", "containers")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(containers).ToNot(BeEmpty())
+
+			nonRoot, _, err := unstructured.NestedBool(containers[0].(map[string]interface{}), "securityContext", "runAsNonRoot")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			if tt.level == "" {
+				// as before pod security levels, the chart values are overridden
+				g.Expect(nonRoot).To(BeTrue())
+			} else {
+				// the values are left to the level, baseline does not require non-root users
+				g.Expect(nonRoot).To(Equal(tt.level == daprApi.PodSecurityLevelRestricted))
+			}
+		})
+	}
+}
+
+func TestRenderOwnerReferences(t *testing.T) {
+	tests := []struct {
+		name string
+		uid  types.UID
+	}{
+		{name: "existing DaprInstance", uid: "5d0c1ad4-8c5e-4bb4-a2b3-6f1b9f0e6f35"},
+		{name: "DaprInstance not created yet", uid: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := instance.NewRenderer(newClient(), helm.Options{ChartsDir: chartsDir})
+			r.ClusterType = controller.ClusterTypeVanilla
+
+			items, err := r.Render(t.Context(), &daprApi.DaprInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      instance.DaprInstanceResourceName,
+					Namespace: "dapr-system",
+					UID:       tt.uid,
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for i := range items {
+				// only the namespaced resources can be owned by the DaprInstance
+				if tt.uid == "" || items[i].GetNamespace() == "" {
+					g.Expect(items[i].GetOwnerReferences()).To(BeEmpty(), items[i].GetKind())
+
+					continue
+				}
+
+				g.Expect(items[i].GetNamespace()).To(Equal("dapr-system"))
+				g.Expect(items[i].GetOwnerReferences()).To(HaveExactElements(
+					HaveField("UID", tt.uid),
+				), items[i].GetKind())
+			}
+
+			g.Expect(find(items, "ClusterRole", "dapr-operator-admin")).ToNot(BeNil())
+			g.Expect(find(items, "Deployment", "dapr-operator")).ToNot(BeNil())
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlRt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+	"github.com/dapr/kubernetes-operator/pkg/maintenance"
+	"github.com/dapr/kubernetes-operator/pkg/podsecurity"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-dapr-io-v1beta1-daprinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.dapr.io,resources=daprinstances,verbs=create;update,versions=v1beta1,name=vdaprinstance.operator.dapr.io,admissionReviewVersions=v1
+
+var ErrUnexpectedObject = errors.New("unexpected object")
+
+var _ admission.CustomValidator = &Validator{}
+
+func NewValidator(c *client.Client, o helm.Options) *Validator {
+	return &Validator{
+		client:  c,
+		options: o,
+		engine:  helme.New(),
+		charts:  newHelmCharts(),
+		schemas: newValuesSchemas(),
+	}
+}
+
+// Validator rejects the DaprInstance resources that the controller would fail to reconcile,
+// that is when:
+//
+// - another DaprInstance exists
+// - the common or pod labels and annotations are not valid, or set the release labels
+// - the chart cannot be loaded or rendered with the given values, or the patches cannot be
+// applied, patches and placement components that match no resource are reported as warnings
+// - the values transforms cannot be parsed or fail to evaluate
+// - the values do not comply with the schema of the chart, top level keys that are
+// unknown to the chart are reported as warnings
+// - the transition from the installed chart version to the requested one is not supported
+// and it is not overridden by the operator.dapr.io/upgrade-override annotation
+// - the rendered workloads do not comply with the pod security level, if set
+// - the rendered PodDisruptionBudgets do not allow any pod of a workload to be evicted
+//
+// The chart is rendered in dry-run mode, nothing is applied to the cluster.
+type Validator struct {
+	client  *client.Client
+	options helm.Options
+	engine  *helme.Instance
+	charts  *helmCharts
+	schemas *valuesSchemas
+}
+
+func (v *Validator) SetupWithManager(manager ctrlRt.Manager) error {
+	//nolint:wrapcheck
+	return ctrlRt.NewWebhookManagedBy(manager).
+		For(&daprApi.DaprInstance{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	res, ok := obj.(*daprApi.DaprInstance)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnexpectedObject, obj)
+	}
+
+	return v.validate(ctx, res, nil)
+}
+
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	res, ok := newObj.(*daprApi.DaprInstance)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnexpectedObject, newObj)
+	}
+
+	old, ok := oldObj.(*daprApi.DaprInstance)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnexpectedObject, oldObj)
+	}
+
+	// changes that do not affect the rendering, as adding or removing finalizers or
+	// annotations, must not be blocked, in particular while the resource is being deleted
+	if !res.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(old.Spec, res.Spec) {
+		return nil, nil
+	}
+
+	return v.validate(ctx, res, old)
+}
+
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *Validator) validate(ctx context.Context, res *daprApi.DaprInstance, old *daprApi.DaprInstance) (admission.Warnings, error) {
+	errs := field.ErrorList{}
+
+	if err := v.validateSingleton(ctx, res); err != nil {
+		errs = append(errs, err)
+	}
+
+	errs = append(errs, validateMetadata(&res.Spec)...)
+	errs = append(errs, validateMaintenanceWindows(res.Spec.MaintenanceWindows)...)
+
+	// the installed chart is only known to the stored resource
+	in := res.DeepCopy()
+	in.Status = daprApi.DaprInstanceStatus{}
+
+	if old != nil {
+		in.Status.Chart = old.Status.Chart.DeepCopy()
+	}
+
+	warnings, chartErrs := v.validateChart(ctx, in)
+	errs = append(errs, chartErrs...)
+
+	if old != nil && old.Status.Chart != nil && old.Spec.Profile != res.Spec.Profile {
+		warnings = append(warnings, validateStorageSize(old, res)...)
+	}
+
+	if len(errs) == 0 {
+		return warnings, nil
+	}
+
+	return warnings, k8serrors.NewInvalid(
+		daprApi.GroupVersion.WithKind("DaprInstance").GroupKind(),
+		res.Name,
+		errs)
+}
+
+func (v *Validator) validateSingleton(ctx context.Context, res *daprApi.DaprInstance) *field.Error {
+	list := daprApi.DaprInstanceList{}
+
+	if err := v.client.List(ctx, &list); err != nil {
+		return field.InternalError(field.NewPath("metadata", "name"), err)
+	}
+
+	for i := range list.Items {
+		if list.Items[i].Namespace == res.Namespace && list.Items[i].Name == res.Name {
+			continue
+		}
+
+		return field.Forbidden(
+			field.NewPath("metadata", "name"),
+			fmt.Sprintf("a DaprInstance already exists: %s/%s", list.Items[i].Namespace, list.Items[i].Name))
+	}
+
+	return nil
+}
+
+func (v *Validator) validateChart(ctx context.Context, res *daprApi.DaprInstance) (admission.Warnings, field.ErrorList) {
+	rr, err := newReconciliationRequest(v.client, v.engine, v.charts, v.options, res)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(field.NewPath("spec", "values"), field.OmitValueType{}, err.Error())}
+	}
+
+	c, err := rr.Chart(ctx)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(field.NewPath("spec", "chart"), field.OmitValueType{}, err.Error())}
+	}
+
+	warnings := admission.Warnings{}
+	errs := field.ErrorList{}
+
+	if rr.InstalledChart != nil && rr.InstalledChart.Version != "" && !upgradeOverridden(&rr) {
+		err := helm.ValidateUpgrade(rr.InstalledChart.Version, c.Version())
+
+		// versions that are not semver compliant cannot be validated
+		if errors.Is(err, helm.ErrUnsupportedUpgrade) {
+			errs = append(errs, field.Forbidden(
+				field.NewPath("spec", "chart", "version"),
+				fmt.Sprintf("%s, set the %s annotation to override", err.Error(), DaprInstanceUpgradeOverrideAnnotation)))
+		}
+	}
+
+	if transformErrs := validateValuesTransforms(rr.Resource.Spec.ValuesTransforms); len(transformErrs) > 0 {
+		return warnings, append(errs, transformErrs...)
+	}
+
+	unknown, err := v.schemas.Validate(ctx, &rr)
+
+	switch {
+	case errors.Is(err, helm.ErrInvalidValues):
+		errs = append(errs, field.Invalid(field.NewPath("spec", "values"), field.OmitValueType{}, err.Error()))
+
+		// rendering would fail, or produce resources with invalid values
+		return warnings, errs
+	case errors.Is(err, ErrInvalidValuesTransform):
+		errs = append(errs, field.Invalid(field.NewPath("spec", "valuesTransforms"), field.OmitValueType{}, err.Error()))
+
+		return warnings, errs
+	case err != nil:
+		errs = append(errs, field.InternalError(field.NewPath("spec", "values"), err))
+	default:
+		for _, k := range unknown {
+			warnings = append(warnings, fmt.Sprintf("spec.values.%s: unknown value, ignored by the chart", k))
+		}
+	}
+
+	items, err := rr.Render(ctx)
+
+	switch {
+	case errors.Is(err, ErrInvalidPatch):
+		errs = append(errs, field.Invalid(field.NewPath("spec", "patches"), field.OmitValueType{}, err.Error()))
+
+		return warnings, errs
+	case err != nil:
+		errs = append(errs, field.Invalid(field.NewPath("spec", "values"), field.OmitValueType{}, err.Error()))
+
+		return warnings, errs
+	}
+
+	for _, i := range rr.Helm.unmatchedPatches {
+		warnings = append(warnings, fmt.Sprintf("spec.patches[%d]: matches no resource", i))
+	}
+
+	for _, c := range rr.Helm.unmatchedComponents {
+		warnings = append(warnings, fmt.Sprintf("spec.placement.components[%s]: matches no workload", c))
+	}
+
+	warnings = append(warnings, validateAutoscaling(&rr, items)...)
+
+	if err := validatePodSecurity(&rr, items); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateDisruptionBudgets(&rr, items); err != nil {
+		errs = append(errs, err)
+	}
+
+	return warnings, errs
+}
+
+// validateMetadata validates the common and pod labels and annotations, the labels managed by
+// the controller cannot be set.
+func validateMetadata(spec *daprApi.DaprInstanceSpec) field.ErrorList {
+	errs := field.ErrorList{}
+	errs = append(errs, validateLabels(spec.CommonLabels, field.NewPath("spec", "commonLabels"))...)
+	errs = append(errs, validateLabels(spec.PodLabels, field.NewPath("spec", "podLabels"))...)
+	errs = append(errs, apivalidation.ValidateAnnotations(spec.CommonAnnotations, field.NewPath("spec", "commonAnnotations"))...)
+	errs = append(errs, apivalidation.ValidateAnnotations(spec.PodAnnotations, field.NewPath("spec", "podAnnotations"))...)
+
+	return errs
+}
+
+// validateMaintenanceWindows validates the maintenance windows, which would otherwise fail to be
+// evaluated, or never open, once the controller holds a change.
+func validateMaintenanceWindows(windows []daprApi.MaintenanceWindow) field.ErrorList {
+	errs := field.ErrorList{}
+
+	for i := range windows {
+		if err := maintenance.Validate(windows[i]); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "maintenanceWindows").Index(i), windows[i], err.Error()))
+		}
+	}
+
+	return errs
+}
+
+func validateLabels(l map[string]string, path *field.Path) field.ErrorList {
+	errs := metav1validation.ValidateLabels(l, path)
+
+	for _, k := range reservedLabels {
+		if _, ok := l[k]; ok {
+			errs = append(errs, field.Forbidden(path.Key(k), "the label is managed by the controller"))
+		}
+	}
+
+	return errs
+}
+
+// validateAutoscaling warns when the utilization of a resource is targeted for a Deployment
+// whose containers do not request such resource, as the HorizontalPodAutoscaler would not
+// be able to compute it.
+func validateAutoscaling(rr *ReconciliationRequest, items []unstructured.Unstructured) admission.Warnings {
+	warnings := admission.Warnings{}
+
+	deployments := autoscaledDeployments(rr.Resource.Spec.Autoscaling)
+
+	for i := range items {
+		s, ok := deployments[items[i].GetName()]
+		if !ok || items[i].GetKind() != "Deployment" {
+			continue
+		}
+
+		sizing, err := workloadSizing(&items[i])
+		if err != nil {
+			continue
+		}
+
+		targets := utilizationTargets(s)
+
+		// the default target of a HorizontalPodAutoscaler is the CPU utilization
+		if len(targets) == 0 {
+			targets = append(targets, utilizationTarget{name: corev1.ResourceCPU})
+		}
+
+		for _, t := range targets {
+			for _, c := range sizing.Containers {
+				if _, ok := c.Resources.Requests[t.name]; !ok {
+					warnings = append(warnings, fmt.Sprintf(
+						"spec.autoscaling: the %s utilization of %s cannot be computed, container %s does not request %s",
+						t.name, items[i].GetName(), c.Name, t.name))
+				}
+			}
+		}
+	}
+
+	return warnings
+}
+
+// validateStorageSize warns when a change of the sizing profile changes the storage size of
+// the scheduler, as the volume claim templates of an existing StatefulSet cannot be changed.
+func validateStorageSize(old *daprApi.DaprInstance, res *daprApi.DaprInstance) admission.Warnings {
+	ov, err := chartValues(&old.Spec)
+	if err != nil {
+		return nil
+	}
+
+	nv, err := chartValues(&res.Spec)
+	if err != nil {
+		return nil
+	}
+
+	oldSize, _, _ := unstructured.NestedString(ov, "dapr_scheduler", "cluster", "storageSize")
+	newSize, _, _ := unstructured.NestedString(nv, "dapr_scheduler", "cluster", "storageSize")
+
+	if oldSize == newSize {
+		return nil
+	}
+
+	return admission.Warnings{
+		fmt.Sprintf("spec.profile: the scheduler storage size changes from %q to %q, the StatefulSet cannot be updated until it is deleted with --cascade=orphan and the existing volumes are not resized", oldSize, newSize),
+	}
+}
+
+// validateValuesTransforms parses each of the given jq expressions, so all the syntax errors
+// are reported at once.
+func validateValuesTransforms(expressions []string) field.ErrorList {
+	errs := field.ErrorList{}
+
+	for i := range expressions {
+		if _, err := gojq.Parse(expressions[i]); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "valuesTransforms").Index(i), expressions[i], err.Error()))
+		}
+	}
+
+	return errs
+}
+
+func validatePodSecurity(rr *ReconciliationRequest, items []unstructured.Unstructured) *field.Error {
+	level, ok := rr.PodSecurityLevel()
+	if !ok {
+		return nil
+	}
+
+	e, err := podsecurity.NewEvaluator(level)
+	if err != nil {
+		return field.InternalError(field.NewPath("spec", "security", "podSecurity"), err)
+	}
+
+	violations, err := podSecurityViolations(e, items)
+	if err != nil {
+		return field.InternalError(field.NewPath("spec", "security", "podSecurity"), err)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return field.Forbidden(
+		field.NewPath("spec", "security", "podSecurity"),
+		"workloads violate the "+string(level)+" pod security level: "+podsecurity.Summary(violations))
+}
+
+// validateDisruptionBudgets forbids PodDisruptionBudgets that block node drains entirely, as
+// they would prevent any pod of the workloads they select to be evicted.
+func validateDisruptionBudgets(rr *ReconciliationRequest, items []unstructured.Unstructured) *field.Error {
+	blocking, err := blockingDisruptionBudgets(rr, items)
+	if err != nil {
+		return field.InternalError(field.NewPath("spec", "availability", "podDisruptionBudgets"), err)
+	}
+
+	if len(blocking) == 0 {
+		return nil
+	}
+
+	return field.Forbidden(
+		field.NewPath("spec", "availability", "podDisruptionBudgets"),
+		"PodDisruptionBudgets block node drains: "+disruptionBudgetsSummary(blocking))
+}
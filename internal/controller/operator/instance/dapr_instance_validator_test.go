@@ -0,0 +1,257 @@
+package instance_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+func newValidator(objects ...runtime.Object) *instance.Validator {
+	return instance.NewValidator(newClient(objects...), helm.Options{ChartsDir: chartsDir})
+}
+
+// causes returns the fields reported by the given validation error.
+func causes(t *testing.T, err error) []string {
+	t.Helper()
+
+	var status *k8serrors.StatusError
+	if !k8serrors.IsInvalid(err) || !errors.As(err, &status) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	answer := make([]string, 0, len(status.ErrStatus.Details.Causes))
+
+	for _, c := range status.ErrStatus.Details.Causes {
+		answer = append(answer, c.Field)
+	}
+
+	return answer
+}
+
+func TestValidatorSingleton(t *testing.T) {
+	t.Run("other instance", func(t *testing.T) {
+		g := NewWithT(t)
+
+		other := daprInstance(1, "")
+		other.Name = "other"
+		other.Namespace = "default"
+
+		_, err := newValidator(other).ValidateCreate(t.Context(), daprInstance(1, ""))
+		g.Expect(causes(t, err)).To(ConsistOf("metadata.name"))
+		g.Expect(err.Error()).To(ContainSubstring("a DaprInstance already exists: default/other"))
+	})
+
+	t.Run("same instance", func(t *testing.T) {
+		g := NewWithT(t)
+
+		warnings, err := newValidator(daprInstance(1, "")).ValidateCreate(t.Context(), daprInstance(1, ""))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(warnings).To(BeEmpty())
+	})
+}
+
+func TestValidatorUpgrade(t *testing.T) {
+	tests := []struct {
+		name        string
+		installed   string
+		annotations map[string]string
+		valid       bool
+	}{
+		{
+			name:      "patch upgrade",
+			installed: "1.16.0",
+			valid:     true,
+		},
+		{
+			name:      "minor upgrade",
+			installed: "1.15.5",
+			valid:     true,
+		},
+		{
+			name:      "skipping minor versions",
+			installed: "1.14.4",
+			valid:     false,
+		},
+		{
+			name:      "downgrade",
+			installed: "1.17.0",
+			valid:     false,
+		},
+		{
+			name:        "overridden",
+			installed:   "1.14.4",
+			annotations: map[string]string{instance.DaprInstanceUpgradeOverrideAnnotation: "true"},
+			valid:       true,
+		},
+		{
+			name:        "invalid override",
+			installed:   "1.14.4",
+			annotations: map[string]string{instance.DaprInstanceUpgradeOverrideAnnotation: "yes please"},
+			valid:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			old := daprInstance(1, "")
+			old.Status.Chart = &daprApi.ChartMeta{Version: tt.installed}
+
+			res := daprInstance(2, `{"global":{"logAsJson":true}}`)
+			res.Annotations = tt.annotations
+
+			_, err := newValidator(old).ValidateUpdate(t.Context(), old, res)
+
+			if tt.valid {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(causes(t, err)).To(ConsistOf("spec.chart.version"))
+				g.Expect(err.Error()).To(ContainSubstring(instance.DaprInstanceUpgradeOverrideAnnotation))
+			}
+		})
+	}
+}
+
+func TestValidatorUpdateUnchangedSpec(t *testing.T) {
+	g := NewWithT(t)
+
+	old := daprInstance(1, "")
+	old.Status.Chart = &daprApi.ChartMeta{Version: "1.14.4"}
+
+	// metadata only changes are not validated, even if the spec would not be valid anymore
+	res := old.DeepCopy()
+	res.Finalizers = []string{"foo"}
+
+	warnings, err := newValidator(old).ValidateUpdate(t.Context(), old, res)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+
+	// changes are not validated while the resource is being deleted
+	res.Spec.ValuesTransforms = []string{"."}
+	now := metav1.Now()
+	res.DeletionTimestamp = &now
+
+	warnings, err = newValidator(old).ValidateUpdate(t.Context(), old, res)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+}
+
+func TestValidatorRender(t *testing.T) {
+	privileged := daprApi.Patch{
+		Target: daprApi.PatchTarget{Kind: "Deployment", Name: "dapr-operator"},
+		Patch:  `{"spec":{"template":{"spec":{"containers":[{"name":"dapr-operator","securityContext":{"privileged":true}}]}}}}`,
+	}
+
+	tests := []struct {
+		name     string
+		spec     daprApi.DaprInstanceSpec
+		fields   []string
+		warnings []string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "unknown values",
+			spec: daprApi.DaprInstanceSpec{
+				Values: &daprApi.JSON{RawMessage: daprApi.RawMessage(`{"foo":"bar"}`)},
+			},
+			warnings: []string{"spec.values.foo: unknown value, ignored by the chart"},
+		},
+		{
+			name: "invalid values transforms",
+			spec: daprApi.DaprInstanceSpec{
+				ValuesTransforms: []string{`.global.ha.enabled = true`, `.global |`},
+			},
+			fields: []string{"spec.valuesTransforms[1]"},
+		},
+		{
+			name: "invalid patch",
+			spec: daprApi.DaprInstanceSpec{
+				Patches: []daprApi.Patch{{
+					Target: daprApi.PatchTarget{Kind: "Deployment", Name: "dapr-operator"},
+					Type:   daprApi.PatchTypeJSON6902,
+					Patch:  `[{"op":"replace","path":"/spec/missing/field","value":1}]`,
+				}},
+			},
+			fields: []string{"spec.patches"},
+		},
+		{
+			name: "unmatched patch",
+			spec: daprApi.DaprInstanceSpec{
+				Patches: []daprApi.Patch{{
+					Target: daprApi.PatchTarget{Kind: "Deployment", Name: "missing"},
+					Patch:  `{"metadata":{"labels":{"foo":"bar"}}}`,
+				}},
+			},
+			warnings: []string{"spec.patches[0]: matches no resource"},
+		},
+		{
+			name: "privileged without pod security",
+			spec: daprApi.DaprInstanceSpec{
+				Patches: []daprApi.Patch{privileged},
+			},
+		},
+		{
+			name: "privileged with pod security",
+			spec: daprApi.DaprInstanceSpec{
+				Security: &daprApi.SecuritySpec{PodSecurity: daprApi.PodSecurityLevelBaseline},
+				Patches:  []daprApi.Patch{privileged},
+			},
+			fields: []string{"spec.security.podSecurity"},
+		},
+		{
+			name: "reserved labels",
+			spec: daprApi.DaprInstanceSpec{
+				CommonLabels: map[string]string{helm.ReleaseName: "dapr"},
+			},
+			fields: []string{"spec.commonLabels[" + helm.ReleaseName + "]"},
+		},
+		{
+			name: "invalid maintenance windows",
+			spec: daprApi.DaprInstanceSpec{
+				MaintenanceWindows: []daprApi.MaintenanceWindow{
+					{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					{Schedule: "not a schedule", Duration: metav1.Duration{Duration: time.Hour}},
+					{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Second}},
+					{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+				},
+			},
+			fields: []string{
+				"spec.maintenanceWindows[1]",
+				"spec.maintenanceWindows[2]",
+				"spec.maintenanceWindows[3]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res := daprInstance(1, "")
+			res.Spec = tt.spec
+
+			warnings, err := newValidator().ValidateCreate(t.Context(), res)
+
+			if len(tt.fields) == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(causes(t, err)).To(ConsistOf(tt.fields))
+			}
+
+			g.Expect(warnings).To(ConsistOf(tt.warnings))
+		})
+	}
+}
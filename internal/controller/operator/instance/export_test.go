@@ -0,0 +1,103 @@
+package instance //nolint:testpackage
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+)
+
+// The unexported functions and types below are exported to the instance_test package only.
+
+type UpgradeStepObjects = upgradeStepObjects
+
+var WorkloadAvailable = workloadAvailable
+
+func UpgradeOrder(workloads []unstructured.Unstructured) []UpgradeStepObjects {
+	return newUpgradeOrchestrator(logr.Discard()).steps(workloads)
+}
+
+func Upgrading(ctx context.Context, rc *ReconciliationRequest, version string, workloads []unstructured.Unstructured) (bool, error) {
+	//nolint:wrapcheck
+	return newUpgradeOrchestrator(logr.Discard()).upgrading(ctx, rc, version, workloads)
+}
+
+type HelmCharts = helmCharts
+
+var NewHelmCharts = newHelmCharts
+
+func ResolveChartVersion(ctx context.Context, rr *ReconciliationRequest, charts *HelmCharts) (string, error) {
+	rr.Helm.charts = charts
+
+	return rr.resolveChartVersion(ctx)
+}
+
+// NewTestReconciler returns a Reconciler recording the events with the given recorder, as
+// the actions emit events through the Reconciler of the request.
+func NewTestReconciler(c *client.Client, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		client:     c,
+		recorder:   recorder,
+		helmEngine: helme.New(),
+		helmCharts: newHelmCharts(),
+	}
+}
+
+// LoadChart loads the chart of the given directory as the chart of the given request.
+func LoadChart(ctx context.Context, rr *ReconciliationRequest, dir string) error {
+	c, err := helme.New().Load(ctx, helme.ChartSpec{Name: dir})
+	if err != nil {
+		//nolint:wrapcheck
+		return err
+	}
+
+	rr.Helm.chart = c
+
+	return nil
+}
+
+type Plan = plan
+
+var ValuesDigest = valuesDigest
+
+func ComputePlan(ctx context.Context, rr *ReconciliationRequest) (*Plan, error) {
+	return newPlanner().Compute(ctx, rr)
+}
+
+func NewReconciliationRequest(c *client.Client, o helm.Options, res *daprApi.DaprInstance) (ReconciliationRequest, error) {
+	return newReconciliationRequest(c, helme.New(), newHelmCharts(), o, res)
+}
+
+// Fail marks the given request as failed, as done when any action fails.
+func Fail(rr *ReconciliationRequest) {
+	rr.failed = true
+}
+
+var (
+	ApplyAutoscaling          = applyAutoscaling
+	OwnsReplicas              = ownsReplicas
+	ApplyAvailability         = applyAvailability
+	IsPodDisruptionBudget     = isPodDisruptionBudget
+	IsPriorityClass           = isPriorityClass
+	NewPriorityClass          = priorityClass
+	ReplacePriorityClass      = replacePriorityClass
+	AllowedDisruptions        = allowedDisruptions
+	BlockingDisruptionBudgets = blockingDisruptionBudgets
+	ApplyPatches              = applyPatches
+	DescribePatches           = describePatches
+	ApplyPlacement            = applyPlacement
+	OverrideScheduling        = overrideScheduling
+)
+
+type ClusterProfile = clusterProfile
+
+func ProfileFor(t controller.ClusterType) ClusterProfile {
+	return profileFor(t)
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlRt "sigs.k8s.io/controller-runtime"
+	ctrlCli "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/dapr/kubernetes-operator/pkg/controller/client"
+)
+
+const (
+	// MigrationInterval is how often a failed migration is attempted again.
+	MigrationInterval = 30 * time.Second
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.dapr.io,resources=daprcruiscontrols,verbs=get;list;update
+
+var _ manager.LeaderElectionRunnable = &Migrator{}
+
+func NewMigrator(c *client.Client, crds ...string) *Migrator {
+	return &Migrator{
+		l:      ctrlRt.Log.WithName("storage-migrator"),
+		client: c,
+		crds:   crds,
+	}
+}
+
+// Migrator rewrites the objects of the given custom resources that have been persisted in
+// a version other than the current storage version, then drops the obsolete versions from
+// the stored versions of the related CustomResourceDefinition, so they can eventually be
+// removed from the CustomResourceDefinition.
+//
+// Objects are rewritten by issuing an update with no changes, which makes the API server
+// encode them in the storage version.
+type Migrator struct {
+	l      logr.Logger
+	client *client.Client
+	crds   []string
+}
+
+func (m *Migrator) NeedLeaderElection() bool {
+	return true
+}
+
+func (m *Migrator) Start(ctx context.Context) error {
+	for _, name := range m.crds {
+		err := wait.PollUntilContextCancel(ctx, MigrationInterval, true, func(ctx context.Context) (bool, error) {
+			if err := m.migrate(ctx, name); err != nil {
+				m.l.Error(err, "storage version migration failed, retrying", "crd", name)
+
+				return false, nil
+			}
+
+			return true, nil
+		})
+		if err != nil {
+			return fmt.Errorf("storage version migration of %s interrupted: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) migrate(ctx context.Context, name string) error {
+	crd, err := m.client.CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to get CustomResourceDefinition %s: %w", name, err)
+	}
+
+	version := storageVersion(crd)
+	if version == "" {
+		return nil
+	}
+
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == version {
+		return nil
+	}
+
+	m.l.Info("migrating", "crd", name, "storedVersions", crd.Status.StoredVersions, "storageVersion", version)
+
+	gvk := schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: version,
+		Kind:    crd.Spec.Names.ListKind,
+	}
+
+	list := unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+
+	if err := m.client.List(ctx, &list); err != nil {
+		return fmt.Errorf("unable to list %s: %w", gvk.String(), err)
+	}
+
+	for i := range list.Items {
+		if err := m.rewrite(ctx, &list.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	// the status is re-read as it may have been changed in the meantime
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := m.client.CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+
+		crd.Status.StoredVersions = []string{version}
+
+		_, err = m.client.CustomResourceDefinitions().UpdateStatus(ctx, crd, metav1.UpdateOptions{})
+
+		//nolint:wrapcheck
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update stored versions of CustomResourceDefinition %s: %w", name, err)
+	}
+
+	m.l.Info("migrated", "crd", name, "count", len(list.Items))
+
+	return nil
+}
+
+func (m *Migrator) rewrite(ctx context.Context, obj *unstructured.Unstructured) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := m.client.Update(ctx, obj)
+		if !k8serrors.IsConflict(err) {
+			//nolint:wrapcheck
+			return err
+		}
+
+		if err := m.client.Get(ctx, ctrlCli.ObjectKeyFromObject(obj), obj); err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+
+		//nolint:wrapcheck
+		return err
+	})
+
+	switch {
+	case k8serrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("unable to rewrite %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	default:
+		return nil
+	}
+}
+
+func storageVersion(crd *apiextv1.CustomResourceDefinition) string {
+	i := slices.IndexFunc(crd.Spec.Versions, func(v apiextv1.CustomResourceDefinitionVersion) bool {
+		return v.Storage
+	})
+
+	if i < 0 {
+		return ""
+	}
+
+	return crd.Spec.Versions[i].Name
+}
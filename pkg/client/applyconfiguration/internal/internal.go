@@ -41,12 +41,18 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.ChartMeta
   map:
     fields:
+    - name: constraint
+      type:
+        scalar: string
     - name: name
       type:
         scalar: string
     - name: repo
       type:
         scalar: string
+    - name: resolvedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
     - name: version
       type:
         scalar: string
@@ -59,12 +65,31 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: repo
       type:
         scalar: string
+    - name: resolveInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
     - name: secret
       type:
         scalar: string
     - name: version
       type:
         scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.ChartUpdate
+  map:
+    fields:
+    - name: major
+      type:
+        scalar: boolean
+    - name: minor
+      type:
+        scalar: boolean
+    - name: patch
+      type:
+        scalar: boolean
+    - name: version
+      type:
+        scalar: string
+      default: ""
 - name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.DaprControlPlane
   map:
     fields:
@@ -89,6 +114,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.DaprControlPlaneSpec
   map:
     fields:
+    - name: chart
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.ChartSpec
     - name: values
       type:
         namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.JSON
@@ -187,15 +215,30 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.DaprInstanceSpec
   map:
     fields:
+    - name: approvalPolicy
+      type:
+        scalar: string
     - name: chart
       type:
         namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.ChartSpec
+    - name: maintenanceWindows
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.MaintenanceWindow
+          elementRelationship: atomic
     - name: values
       type:
         namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.JSON
 - name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.DaprInstanceStatus
   map:
     fields:
+    - name: availableUpdates
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.ChartUpdate
+          elementRelationship: atomic
     - name: chart
       type:
         namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.ChartMeta
@@ -212,6 +255,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: plan
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.PlanStatus
+    - name: workloadsDigest
+      type:
+        scalar: string
 - name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.JSON
   map:
     elementType:
@@ -224,32 +273,220 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
-- name: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+- name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.MaintenanceWindow
   map:
     fields:
-    - name: lastTransitionTime
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+    - name: timeZone
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1alpha1.PlanStatus
+  map:
+    fields:
+    - name: approved
+      type:
+        scalar: boolean
+      default: false
+    - name: configMap
+      type:
+        scalar: string
+      default: ""
+    - name: create
+      type:
+        scalar: numeric
+      default: 0
+    - name: hash
+      type:
+        scalar: string
+      default: ""
+    - name: prune
+      type:
+        scalar: numeric
+      default: 0
+    - name: update
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.AutoscalingSpec
+  map:
+    fields:
+    - name: operator
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ComponentAutoscalingSpec
+    - name: sidecarInjector
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ComponentAutoscalingSpec
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.AvailabilitySpec
+  map:
+    fields:
+    - name: podDisruptionBudgets
+      type:
+        scalar: string
+    - name: priorityClass
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PriorityClassSpec
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartMeta
+  map:
+    fields:
+    - name: constraint
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: repo
+      type:
+        scalar: string
+    - name: resolvedAt
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
-    - name: message
+    - name: version
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartSpec
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: repo
+      type:
+        scalar: string
+    - name: resolveInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: secret
+      type:
+        scalar: string
+    - name: version
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartUpdate
+  map:
+    fields:
+    - name: major
+      type:
+        scalar: boolean
+    - name: minor
+      type:
+        scalar: boolean
+    - name: patch
+      type:
+        scalar: boolean
+    - name: version
       type:
         scalar: string
       default: ""
-    - name: observedGeneration
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ClusterProfileSpec
+  map:
+    fields:
+    - name: openShift
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.OpenShiftProfileSpec
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ComponentAutoscalingSpec
+  map:
+    fields:
+    - name: maxReplicas
       type:
         scalar: numeric
-    - name: reason
+      default: 0
+    - name: minReplicas
+      type:
+        scalar: numeric
+    - name: targetCPUUtilizationPercentage
+      type:
+        scalar: numeric
+    - name: targetMemoryUtilizationPercentage
+      type:
+        scalar: numeric
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ContainerSizing
+  map:
+    fields:
+    - name: name
       type:
         scalar: string
       default: ""
+    - name: resources
+      type:
+        namedType: io.k8s.api.core.v1.ResourceRequirements
+      default: {}
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprControlPlane
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprControlPlaneSpec
+      default: {}
     - name: status
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprControlPlaneStatus
+      default: {}
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprControlPlaneSpec
+  map:
+    fields:
+    - name: chart
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartSpec
+    - name: values
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.JSON
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprControlPlaneStatus
+  map:
+    fields:
+    - name: chart
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartMeta
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: phase
       type:
         scalar: string
       default: ""
-    - name: type
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprCruiseControl
+  map:
+    fields:
+    - name: apiVersion
       type:
         scalar: string
-      default: ""
-- name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprCruiseControlSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprCruiseControlStatus
+      default: {}
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprCruiseControlSpec
   map:
     elementType:
       scalar: untyped
@@ -261,6 +498,664 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprCruiseControlStatus
+  map:
+    fields:
+    - name: chart
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartMeta
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: phase
+      type:
+        scalar: string
+      default: ""
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprInstance
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprInstanceSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprInstanceStatus
+      default: {}
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprInstanceSpec
+  map:
+    fields:
+    - name: approvalPolicy
+      type:
+        scalar: string
+    - name: autoscaling
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.AutoscalingSpec
+    - name: availability
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.AvailabilitySpec
+    - name: chart
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartSpec
+    - name: clusterProfile
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ClusterProfileSpec
+    - name: commonAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: commonLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: maintenanceWindows
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.MaintenanceWindow
+          elementRelationship: atomic
+    - name: patches
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.Patch
+          elementRelationship: atomic
+    - name: placement
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PlacementSpec
+    - name: podAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: podLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: profile
+      type:
+        scalar: string
+    - name: security
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.SecuritySpec
+    - name: values
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.JSON
+    - name: valuesTransforms
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.DaprInstanceStatus
+  map:
+    fields:
+    - name: availableUpdates
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartUpdate
+          elementRelationship: atomic
+    - name: chart
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ChartMeta
+    - name: clusterProfile
+      type:
+        scalar: string
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: phase
+      type:
+        scalar: string
+      default: ""
+    - name: plan
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PlanStatus
+    - name: sizing
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.SizingStatus
+    - name: workloadsDigest
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.JSON
+  map:
+    elementType:
+      scalar: untyped
+      list:
+        elementType:
+          namedType: __untyped_atomic_
+        elementRelationship: atomic
+      map:
+        elementType:
+          namedType: __untyped_deduced_
+        elementRelationship: separable
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.MaintenanceWindow
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+    - name: timeZone
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.OpenShiftProfileSpec
+  map:
+    fields:
+    - name: dashboardRoute
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.RouteSpec
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.Patch
+  map:
+    fields:
+    - name: patch
+      type:
+        scalar: string
+      default: ""
+    - name: target
+      type:
+        namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PatchTarget
+      default: {}
+    - name: type
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PatchTarget
+  map:
+    fields:
+    - name: group
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: labelSelector
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: version
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PlacementSpec
+  map:
+    fields:
+    - name: affinity
+      type:
+        namedType: io.k8s.api.core.v1.Affinity
+    - name: components
+      type:
+        map:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.SchedulingSpec
+    - name: nodeSelector
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: priorityClassName
+      type:
+        scalar: string
+    - name: tolerations
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.Toleration
+          elementRelationship: atomic
+    - name: topologySpreadConstraints
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.TopologySpreadConstraint
+          elementRelationship: atomic
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PlanStatus
+  map:
+    fields:
+    - name: approved
+      type:
+        scalar: boolean
+      default: false
+    - name: configMap
+      type:
+        scalar: string
+      default: ""
+    - name: create
+      type:
+        scalar: numeric
+      default: 0
+    - name: hash
+      type:
+        scalar: string
+      default: ""
+    - name: prune
+      type:
+        scalar: numeric
+      default: 0
+    - name: update
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.PriorityClassSpec
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: value
+      type:
+        scalar: numeric
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.RouteSpec
+  map:
+    fields:
+    - name: host
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.SchedulingSpec
+  map:
+    fields:
+    - name: affinity
+      type:
+        namedType: io.k8s.api.core.v1.Affinity
+    - name: nodeSelector
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: priorityClassName
+      type:
+        scalar: string
+    - name: tolerations
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.Toleration
+          elementRelationship: atomic
+    - name: topologySpreadConstraints
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.TopologySpreadConstraint
+          elementRelationship: atomic
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.SecuritySpec
+  map:
+    fields:
+    - name: podSecurity
+      type:
+        scalar: string
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.SizingStatus
+  map:
+    fields:
+    - name: profile
+      type:
+        scalar: string
+    - name: workloads
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.WorkloadSizing
+          elementRelationship: atomic
+- name: com.github.dapr.kubernetes-operator.api.operator.v1beta1.WorkloadSizing
+  map:
+    fields:
+    - name: containers
+      type:
+        list:
+          elementType:
+            namedType: com.github.dapr.kubernetes-operator.api.operator.v1beta1.ContainerSizing
+          elementRelationship: atomic
+    - name: kind
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: storageSize
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+- name: io.k8s.api.core.v1.Affinity
+  map:
+    fields:
+    - name: nodeAffinity
+      type:
+        namedType: io.k8s.api.core.v1.NodeAffinity
+    - name: podAffinity
+      type:
+        namedType: io.k8s.api.core.v1.PodAffinity
+    - name: podAntiAffinity
+      type:
+        namedType: io.k8s.api.core.v1.PodAntiAffinity
+- name: io.k8s.api.core.v1.NodeAffinity
+  map:
+    fields:
+    - name: preferredDuringSchedulingIgnoredDuringExecution
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.PreferredSchedulingTerm
+          elementRelationship: atomic
+    - name: requiredDuringSchedulingIgnoredDuringExecution
+      type:
+        namedType: io.k8s.api.core.v1.NodeSelector
+- name: io.k8s.api.core.v1.NodeSelector
+  map:
+    fields:
+    - name: nodeSelectorTerms
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.NodeSelectorTerm
+          elementRelationship: atomic
+    elementRelationship: atomic
+- name: io.k8s.api.core.v1.NodeSelectorRequirement
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: operator
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: io.k8s.api.core.v1.NodeSelectorTerm
+  map:
+    fields:
+    - name: matchExpressions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.NodeSelectorRequirement
+          elementRelationship: atomic
+    - name: matchFields
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.NodeSelectorRequirement
+          elementRelationship: atomic
+    elementRelationship: atomic
+- name: io.k8s.api.core.v1.PodAffinity
+  map:
+    fields:
+    - name: preferredDuringSchedulingIgnoredDuringExecution
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.WeightedPodAffinityTerm
+          elementRelationship: atomic
+    - name: requiredDuringSchedulingIgnoredDuringExecution
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.PodAffinityTerm
+          elementRelationship: atomic
+- name: io.k8s.api.core.v1.PodAffinityTerm
+  map:
+    fields:
+    - name: labelSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: matchLabelKeys
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: mismatchLabelKeys
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: namespaceSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: namespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: topologyKey
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.api.core.v1.PodAntiAffinity
+  map:
+    fields:
+    - name: preferredDuringSchedulingIgnoredDuringExecution
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.WeightedPodAffinityTerm
+          elementRelationship: atomic
+    - name: requiredDuringSchedulingIgnoredDuringExecution
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.PodAffinityTerm
+          elementRelationship: atomic
+- name: io.k8s.api.core.v1.PreferredSchedulingTerm
+  map:
+    fields:
+    - name: preference
+      type:
+        namedType: io.k8s.api.core.v1.NodeSelectorTerm
+      default: {}
+    - name: weight
+      type:
+        scalar: numeric
+      default: 0
+- name: io.k8s.api.core.v1.ResourceClaim
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: request
+      type:
+        scalar: string
+- name: io.k8s.api.core.v1.ResourceRequirements
+  map:
+    fields:
+    - name: claims
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.ResourceClaim
+          elementRelationship: associative
+          keys:
+          - name
+    - name: limits
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: requests
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+- name: io.k8s.api.core.v1.Toleration
+  map:
+    fields:
+    - name: effect
+      type:
+        scalar: string
+    - name: key
+      type:
+        scalar: string
+    - name: operator
+      type:
+        scalar: string
+    - name: tolerationSeconds
+      type:
+        scalar: numeric
+    - name: value
+      type:
+        scalar: string
+- name: io.k8s.api.core.v1.TopologySpreadConstraint
+  map:
+    fields:
+    - name: labelSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: matchLabelKeys
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: maxSkew
+      type:
+        scalar: numeric
+      default: 0
+    - name: minDomains
+      type:
+        scalar: numeric
+    - name: nodeAffinityPolicy
+      type:
+        scalar: string
+    - name: nodeTaintsPolicy
+      type:
+        scalar: string
+    - name: topologyKey
+      type:
+        scalar: string
+      default: ""
+    - name: whenUnsatisfiable
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.api.core.v1.WeightedPodAffinityTerm
+  map:
+    fields:
+    - name: podAffinityTerm
+      type:
+        namedType: io.k8s.api.core.v1.PodAffinityTerm
+      default: {}
+    - name: weight
+      type:
+        scalar: numeric
+      default: 0
+- name: io.k8s.apimachinery.pkg.api.resource.Quantity
+  scalar: untyped
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+      default: ""
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: reason
+      type:
+        scalar: string
+      default: ""
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+  scalar: string
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
+  map:
+    elementType:
+      scalar: untyped
+      list:
+        elementType:
+          namedType: __untyped_atomic_
+        elementRelationship: atomic
+      map:
+        elementType:
+          namedType: __untyped_deduced_
+        elementRelationship: separable
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+  map:
+    fields:
+    - name: matchExpressions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+          elementRelationship: atomic
+    - name: matchLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: operator
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.ManagedFieldsEntry
   map:
     fields:
@@ -17,12 +17,18 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ChartMetaApplyConfiguration represents a declarative configuration of the ChartMeta type for use
 // with apply.
 type ChartMetaApplyConfiguration struct {
-	Repo    *string `json:"repo,omitempty"`
-	Name    *string `json:"name,omitempty"`
-	Version *string `json:"version,omitempty"`
+	Repo       *string  `json:"repo,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+	Version    *string  `json:"version,omitempty"`
+	Constraint *string  `json:"constraint,omitempty"`
+	ResolvedAt *v1.Time `json:"resolvedAt,omitempty"`
 }
 
 // ChartMetaApplyConfiguration constructs a declarative configuration of the ChartMeta type for use with
@@ -54,3 +60,19 @@ func (b *ChartMetaApplyConfiguration) WithVersion(value string) *ChartMetaApplyC
 	b.Version = &value
 	return b
 }
+
+// WithConstraint sets the Constraint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Constraint field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithConstraint(value string) *ChartMetaApplyConfiguration {
+	b.Constraint = &value
+	return b
+}
+
+// WithResolvedAt sets the ResolvedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolvedAt field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithResolvedAt(value v1.Time) *ChartMetaApplyConfiguration {
+	b.ResolvedAt = &value
+	return b
+}
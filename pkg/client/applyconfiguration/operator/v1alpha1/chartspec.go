@@ -17,13 +17,18 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ChartSpecApplyConfiguration represents a declarative configuration of the ChartSpec type for use
 // with apply.
 type ChartSpecApplyConfiguration struct {
-	Repo    *string `json:"repo,omitempty"`
-	Name    *string `json:"name,omitempty"`
-	Version *string `json:"version,omitempty"`
-	Secret  *string `json:"secret,omitempty"`
+	Repo            *string      `json:"repo,omitempty"`
+	Name            *string      `json:"name,omitempty"`
+	Version         *string      `json:"version,omitempty"`
+	Secret          *string      `json:"secret,omitempty"`
+	ResolveInterval *v1.Duration `json:"resolveInterval,omitempty"`
 }
 
 // ChartSpecApplyConfiguration constructs a declarative configuration of the ChartSpec type for use with
@@ -63,3 +68,11 @@ func (b *ChartSpecApplyConfiguration) WithSecret(value string) *ChartSpecApplyCo
 	b.Secret = &value
 	return b
 }
+
+// WithResolveInterval sets the ResolveInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolveInterval field is set to the value of the last call.
+func (b *ChartSpecApplyConfiguration) WithResolveInterval(value v1.Duration) *ChartSpecApplyConfiguration {
+	b.ResolveInterval = &value
+	return b
+}
@@ -20,7 +20,8 @@ package v1alpha1
 // DaprControlPlaneSpecApplyConfiguration represents a declarative configuration of the DaprControlPlaneSpec type for use
 // with apply.
 type DaprControlPlaneSpecApplyConfiguration struct {
-	Values *JSONApplyConfiguration `json:"values,omitempty"`
+	Chart  *ChartSpecApplyConfiguration `json:"chart,omitempty"`
+	Values *JSONApplyConfiguration      `json:"values,omitempty"`
 }
 
 // DaprControlPlaneSpecApplyConfiguration constructs a declarative configuration of the DaprControlPlaneSpec type for use with
@@ -29,6 +30,14 @@ func DaprControlPlaneSpec() *DaprControlPlaneSpecApplyConfiguration {
 	return &DaprControlPlaneSpecApplyConfiguration{}
 }
 
+// WithChart sets the Chart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Chart field is set to the value of the last call.
+func (b *DaprControlPlaneSpecApplyConfiguration) WithChart(value *ChartSpecApplyConfiguration) *DaprControlPlaneSpecApplyConfiguration {
+	b.Chart = value
+	return b
+}
+
 // WithValues sets the Values field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Values field is set to the value of the last call.
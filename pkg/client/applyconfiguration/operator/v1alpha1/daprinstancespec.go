@@ -17,11 +17,17 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	operatorv1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+)
+
 // DaprInstanceSpecApplyConfiguration represents a declarative configuration of the DaprInstanceSpec type for use
 // with apply.
 type DaprInstanceSpecApplyConfiguration struct {
-	Chart  *ChartSpecApplyConfiguration `json:"chart,omitempty"`
-	Values *JSONApplyConfiguration      `json:"values,omitempty"`
+	Chart              *ChartSpecApplyConfiguration          `json:"chart,omitempty"`
+	Values             *JSONApplyConfiguration               `json:"values,omitempty"`
+	MaintenanceWindows []MaintenanceWindowApplyConfiguration `json:"maintenanceWindows,omitempty"`
+	ApprovalPolicy     *operatorv1alpha1.ApprovalPolicy      `json:"approvalPolicy,omitempty"`
 }
 
 // DaprInstanceSpecApplyConfiguration constructs a declarative configuration of the DaprInstanceSpec type for use with
@@ -45,3 +51,24 @@ func (b *DaprInstanceSpecApplyConfiguration) WithValues(value *JSONApplyConfigur
 	b.Values = value
 	return b
 }
+
+// WithMaintenanceWindows adds the given value to the MaintenanceWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceWindows field.
+func (b *DaprInstanceSpecApplyConfiguration) WithMaintenanceWindows(values ...*MaintenanceWindowApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMaintenanceWindows")
+		}
+		b.MaintenanceWindows = append(b.MaintenanceWindows, *values[i])
+	}
+	return b
+}
+
+// WithApprovalPolicy sets the ApprovalPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApprovalPolicy field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithApprovalPolicy(value operatorv1alpha1.ApprovalPolicy) *DaprInstanceSpecApplyConfiguration {
+	b.ApprovalPolicy = &value
+	return b
+}
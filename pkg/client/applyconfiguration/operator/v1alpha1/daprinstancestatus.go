@@ -25,7 +25,10 @@ import (
 // with apply.
 type DaprInstanceStatusApplyConfiguration struct {
 	StatusApplyConfiguration `json:",inline"`
-	Chart                    *ChartMetaApplyConfiguration `json:"chart,omitempty"`
+	Chart                    *ChartMetaApplyConfiguration    `json:"chart,omitempty"`
+	AvailableUpdates         []ChartUpdateApplyConfiguration `json:"availableUpdates,omitempty"`
+	WorkloadsDigest          *string                         `json:"workloadsDigest,omitempty"`
+	Plan                     *PlanStatusApplyConfiguration   `json:"plan,omitempty"`
 }
 
 // DaprInstanceStatusApplyConfiguration constructs a declarative configuration of the DaprInstanceStatus type for use with
@@ -70,3 +73,32 @@ func (b *DaprInstanceStatusApplyConfiguration) WithChart(value *ChartMetaApplyCo
 	b.Chart = value
 	return b
 }
+
+// WithAvailableUpdates adds the given value to the AvailableUpdates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AvailableUpdates field.
+func (b *DaprInstanceStatusApplyConfiguration) WithAvailableUpdates(values ...*ChartUpdateApplyConfiguration) *DaprInstanceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAvailableUpdates")
+		}
+		b.AvailableUpdates = append(b.AvailableUpdates, *values[i])
+	}
+	return b
+}
+
+// WithWorkloadsDigest sets the WorkloadsDigest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkloadsDigest field is set to the value of the last call.
+func (b *DaprInstanceStatusApplyConfiguration) WithWorkloadsDigest(value string) *DaprInstanceStatusApplyConfiguration {
+	b.WorkloadsDigest = &value
+	return b
+}
+
+// WithPlan sets the Plan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Plan field is set to the value of the last call.
+func (b *DaprInstanceStatusApplyConfiguration) WithPlan(value *PlanStatusApplyConfiguration) *DaprInstanceStatusApplyConfiguration {
+	b.Plan = value
+	return b
+}
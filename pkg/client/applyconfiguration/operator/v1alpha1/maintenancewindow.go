@@ -0,0 +1,60 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceWindowApplyConfiguration represents a declarative configuration of the MaintenanceWindow type for use
+// with apply.
+type MaintenanceWindowApplyConfiguration struct {
+	Schedule *string      `json:"schedule,omitempty"`
+	Duration *v1.Duration `json:"duration,omitempty"`
+	TimeZone *string      `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindowApplyConfiguration constructs a declarative configuration of the MaintenanceWindow type for use with
+// apply.
+func MaintenanceWindow() *MaintenanceWindowApplyConfiguration {
+	return &MaintenanceWindowApplyConfiguration{}
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *MaintenanceWindowApplyConfiguration) WithSchedule(value string) *MaintenanceWindowApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithDuration sets the Duration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Duration field is set to the value of the last call.
+func (b *MaintenanceWindowApplyConfiguration) WithDuration(value v1.Duration) *MaintenanceWindowApplyConfiguration {
+	b.Duration = &value
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *MaintenanceWindowApplyConfiguration) WithTimeZone(value string) *MaintenanceWindowApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// AutoscalingSpecApplyConfiguration represents a declarative configuration of the AutoscalingSpec type for use
+// with apply.
+type AutoscalingSpecApplyConfiguration struct {
+	Operator        *ComponentAutoscalingSpecApplyConfiguration `json:"operator,omitempty"`
+	SidecarInjector *ComponentAutoscalingSpecApplyConfiguration `json:"sidecarInjector,omitempty"`
+}
+
+// AutoscalingSpecApplyConfiguration constructs a declarative configuration of the AutoscalingSpec type for use with
+// apply.
+func AutoscalingSpec() *AutoscalingSpecApplyConfiguration {
+	return &AutoscalingSpecApplyConfiguration{}
+}
+
+// WithOperator sets the Operator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Operator field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithOperator(value *ComponentAutoscalingSpecApplyConfiguration) *AutoscalingSpecApplyConfiguration {
+	b.Operator = value
+	return b
+}
+
+// WithSidecarInjector sets the SidecarInjector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SidecarInjector field is set to the value of the last call.
+func (b *AutoscalingSpecApplyConfiguration) WithSidecarInjector(value *ComponentAutoscalingSpecApplyConfiguration) *AutoscalingSpecApplyConfiguration {
+	b.SidecarInjector = value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// AvailabilitySpecApplyConfiguration represents a declarative configuration of the AvailabilitySpec type for use
+// with apply.
+type AvailabilitySpecApplyConfiguration struct {
+	PodDisruptionBudgets *operatorv1beta1.PodDisruptionBudgetPolicy `json:"podDisruptionBudgets,omitempty"`
+	PriorityClass        *PriorityClassSpecApplyConfiguration       `json:"priorityClass,omitempty"`
+}
+
+// AvailabilitySpecApplyConfiguration constructs a declarative configuration of the AvailabilitySpec type for use with
+// apply.
+func AvailabilitySpec() *AvailabilitySpecApplyConfiguration {
+	return &AvailabilitySpecApplyConfiguration{}
+}
+
+// WithPodDisruptionBudgets sets the PodDisruptionBudgets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudgets field is set to the value of the last call.
+func (b *AvailabilitySpecApplyConfiguration) WithPodDisruptionBudgets(value operatorv1beta1.PodDisruptionBudgetPolicy) *AvailabilitySpecApplyConfiguration {
+	b.PodDisruptionBudgets = &value
+	return b
+}
+
+// WithPriorityClass sets the PriorityClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClass field is set to the value of the last call.
+func (b *AvailabilitySpecApplyConfiguration) WithPriorityClass(value *PriorityClassSpecApplyConfiguration) *AvailabilitySpecApplyConfiguration {
+	b.PriorityClass = value
+	return b
+}
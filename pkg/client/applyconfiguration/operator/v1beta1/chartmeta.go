@@ -0,0 +1,78 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChartMetaApplyConfiguration represents a declarative configuration of the ChartMeta type for use
+// with apply.
+type ChartMetaApplyConfiguration struct {
+	Repo       *string  `json:"repo,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+	Version    *string  `json:"version,omitempty"`
+	Constraint *string  `json:"constraint,omitempty"`
+	ResolvedAt *v1.Time `json:"resolvedAt,omitempty"`
+}
+
+// ChartMetaApplyConfiguration constructs a declarative configuration of the ChartMeta type for use with
+// apply.
+func ChartMeta() *ChartMetaApplyConfiguration {
+	return &ChartMetaApplyConfiguration{}
+}
+
+// WithRepo sets the Repo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Repo field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithRepo(value string) *ChartMetaApplyConfiguration {
+	b.Repo = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithName(value string) *ChartMetaApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithVersion(value string) *ChartMetaApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithConstraint sets the Constraint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Constraint field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithConstraint(value string) *ChartMetaApplyConfiguration {
+	b.Constraint = &value
+	return b
+}
+
+// WithResolvedAt sets the ResolvedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolvedAt field is set to the value of the last call.
+func (b *ChartMetaApplyConfiguration) WithResolvedAt(value v1.Time) *ChartMetaApplyConfiguration {
+	b.ResolvedAt = &value
+	return b
+}
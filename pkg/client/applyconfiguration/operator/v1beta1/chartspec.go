@@ -0,0 +1,78 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChartSpecApplyConfiguration represents a declarative configuration of the ChartSpec type for use
+// with apply.
+type ChartSpecApplyConfiguration struct {
+	Repo            *string      `json:"repo,omitempty"`
+	Name            *string      `json:"name,omitempty"`
+	Version         *string      `json:"version,omitempty"`
+	Secret          *string      `json:"secret,omitempty"`
+	ResolveInterval *v1.Duration `json:"resolveInterval,omitempty"`
+}
+
+// ChartSpecApplyConfiguration constructs a declarative configuration of the ChartSpec type for use with
+// apply.
+func ChartSpec() *ChartSpecApplyConfiguration {
+	return &ChartSpecApplyConfiguration{}
+}
+
+// WithRepo sets the Repo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Repo field is set to the value of the last call.
+func (b *ChartSpecApplyConfiguration) WithRepo(value string) *ChartSpecApplyConfiguration {
+	b.Repo = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ChartSpecApplyConfiguration) WithName(value string) *ChartSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ChartSpecApplyConfiguration) WithVersion(value string) *ChartSpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *ChartSpecApplyConfiguration) WithSecret(value string) *ChartSpecApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithResolveInterval sets the ResolveInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolveInterval field is set to the value of the last call.
+func (b *ChartSpecApplyConfiguration) WithResolveInterval(value v1.Duration) *ChartSpecApplyConfiguration {
+	b.ResolveInterval = &value
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ChartUpdateApplyConfiguration represents a declarative configuration of the ChartUpdate type for use
+// with apply.
+type ChartUpdateApplyConfiguration struct {
+	Version *string `json:"version,omitempty"`
+	Patch   *bool   `json:"patch,omitempty"`
+	Minor   *bool   `json:"minor,omitempty"`
+	Major   *bool   `json:"major,omitempty"`
+}
+
+// ChartUpdateApplyConfiguration constructs a declarative configuration of the ChartUpdate type for use with
+// apply.
+func ChartUpdate() *ChartUpdateApplyConfiguration {
+	return &ChartUpdateApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ChartUpdateApplyConfiguration) WithVersion(value string) *ChartUpdateApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithPatch sets the Patch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Patch field is set to the value of the last call.
+func (b *ChartUpdateApplyConfiguration) WithPatch(value bool) *ChartUpdateApplyConfiguration {
+	b.Patch = &value
+	return b
+}
+
+// WithMinor sets the Minor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Minor field is set to the value of the last call.
+func (b *ChartUpdateApplyConfiguration) WithMinor(value bool) *ChartUpdateApplyConfiguration {
+	b.Minor = &value
+	return b
+}
+
+// WithMajor sets the Major field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Major field is set to the value of the last call.
+func (b *ChartUpdateApplyConfiguration) WithMajor(value bool) *ChartUpdateApplyConfiguration {
+	b.Major = &value
+	return b
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ComponentAutoscalingSpecApplyConfiguration represents a declarative configuration of the ComponentAutoscalingSpec type for use
+// with apply.
+type ComponentAutoscalingSpecApplyConfiguration struct {
+	MinReplicas                       *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas                       *int32 `json:"maxReplicas,omitempty"`
+	TargetCPUUtilizationPercentage    *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// ComponentAutoscalingSpecApplyConfiguration constructs a declarative configuration of the ComponentAutoscalingSpec type for use with
+// apply.
+func ComponentAutoscalingSpec() *ComponentAutoscalingSpecApplyConfiguration {
+	return &ComponentAutoscalingSpecApplyConfiguration{}
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ComponentAutoscalingSpecApplyConfiguration) WithMinReplicas(value int32) *ComponentAutoscalingSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ComponentAutoscalingSpecApplyConfiguration) WithMaxReplicas(value int32) *ComponentAutoscalingSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithTargetCPUUtilizationPercentage sets the TargetCPUUtilizationPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetCPUUtilizationPercentage field is set to the value of the last call.
+func (b *ComponentAutoscalingSpecApplyConfiguration) WithTargetCPUUtilizationPercentage(value int32) *ComponentAutoscalingSpecApplyConfiguration {
+	b.TargetCPUUtilizationPercentage = &value
+	return b
+}
+
+// WithTargetMemoryUtilizationPercentage sets the TargetMemoryUtilizationPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetMemoryUtilizationPercentage field is set to the value of the last call.
+func (b *ComponentAutoscalingSpecApplyConfiguration) WithTargetMemoryUtilizationPercentage(value int32) *ComponentAutoscalingSpecApplyConfiguration {
+	b.TargetMemoryUtilizationPercentage = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// DaprControlPlaneSpecApplyConfiguration represents a declarative configuration of the DaprControlPlaneSpec type for use
+// with apply.
+type DaprControlPlaneSpecApplyConfiguration struct {
+	Chart  *ChartSpecApplyConfiguration `json:"chart,omitempty"`
+	Values *JSONApplyConfiguration      `json:"values,omitempty"`
+}
+
+// DaprControlPlaneSpecApplyConfiguration constructs a declarative configuration of the DaprControlPlaneSpec type for use with
+// apply.
+func DaprControlPlaneSpec() *DaprControlPlaneSpecApplyConfiguration {
+	return &DaprControlPlaneSpecApplyConfiguration{}
+}
+
+// WithChart sets the Chart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Chart field is set to the value of the last call.
+func (b *DaprControlPlaneSpecApplyConfiguration) WithChart(value *ChartSpecApplyConfiguration) *DaprControlPlaneSpecApplyConfiguration {
+	b.Chart = value
+	return b
+}
+
+// WithValues sets the Values field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Values field is set to the value of the last call.
+func (b *DaprControlPlaneSpecApplyConfiguration) WithValues(value *JSONApplyConfiguration) *DaprControlPlaneSpecApplyConfiguration {
+	b.Values = value
+	return b
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// DaprInstanceSpecApplyConfiguration represents a declarative configuration of the DaprInstanceSpec type for use
+// with apply.
+type DaprInstanceSpecApplyConfiguration struct {
+	Chart              *ChartSpecApplyConfiguration          `json:"chart,omitempty"`
+	Values             *JSONApplyConfiguration               `json:"values,omitempty"`
+	ValuesTransforms   []string                              `json:"valuesTransforms,omitempty"`
+	MaintenanceWindows []MaintenanceWindowApplyConfiguration `json:"maintenanceWindows,omitempty"`
+	ApprovalPolicy     *operatorv1beta1.ApprovalPolicy       `json:"approvalPolicy,omitempty"`
+	ClusterProfile     *ClusterProfileSpecApplyConfiguration `json:"clusterProfile,omitempty"`
+	Security           *SecuritySpecApplyConfiguration       `json:"security,omitempty"`
+	Patches            []PatchApplyConfiguration             `json:"patches,omitempty"`
+	Profile            *operatorv1beta1.SizingProfile        `json:"profile,omitempty"`
+	Autoscaling        *AutoscalingSpecApplyConfiguration    `json:"autoscaling,omitempty"`
+	Availability       *AvailabilitySpecApplyConfiguration   `json:"availability,omitempty"`
+	Placement          *PlacementSpecApplyConfiguration      `json:"placement,omitempty"`
+	CommonLabels       map[string]string                     `json:"commonLabels,omitempty"`
+	CommonAnnotations  map[string]string                     `json:"commonAnnotations,omitempty"`
+	PodLabels          map[string]string                     `json:"podLabels,omitempty"`
+	PodAnnotations     map[string]string                     `json:"podAnnotations,omitempty"`
+}
+
+// DaprInstanceSpecApplyConfiguration constructs a declarative configuration of the DaprInstanceSpec type for use with
+// apply.
+func DaprInstanceSpec() *DaprInstanceSpecApplyConfiguration {
+	return &DaprInstanceSpecApplyConfiguration{}
+}
+
+// WithChart sets the Chart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Chart field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithChart(value *ChartSpecApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.Chart = value
+	return b
+}
+
+// WithValues sets the Values field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Values field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithValues(value *JSONApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.Values = value
+	return b
+}
+
+// WithValuesTransforms adds the given value to the ValuesTransforms field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ValuesTransforms field.
+func (b *DaprInstanceSpecApplyConfiguration) WithValuesTransforms(values ...string) *DaprInstanceSpecApplyConfiguration {
+	for i := range values {
+		b.ValuesTransforms = append(b.ValuesTransforms, values[i])
+	}
+	return b
+}
+
+// WithMaintenanceWindows adds the given value to the MaintenanceWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceWindows field.
+func (b *DaprInstanceSpecApplyConfiguration) WithMaintenanceWindows(values ...*MaintenanceWindowApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMaintenanceWindows")
+		}
+		b.MaintenanceWindows = append(b.MaintenanceWindows, *values[i])
+	}
+	return b
+}
+
+// WithApprovalPolicy sets the ApprovalPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApprovalPolicy field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithApprovalPolicy(value operatorv1beta1.ApprovalPolicy) *DaprInstanceSpecApplyConfiguration {
+	b.ApprovalPolicy = &value
+	return b
+}
+
+// WithClusterProfile sets the ClusterProfile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterProfile field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithClusterProfile(value *ClusterProfileSpecApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.ClusterProfile = value
+	return b
+}
+
+// WithSecurity sets the Security field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Security field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithSecurity(value *SecuritySpecApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.Security = value
+	return b
+}
+
+// WithPatches adds the given value to the Patches field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Patches field.
+func (b *DaprInstanceSpecApplyConfiguration) WithPatches(values ...*PatchApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPatches")
+		}
+		b.Patches = append(b.Patches, *values[i])
+	}
+	return b
+}
+
+// WithProfile sets the Profile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Profile field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithProfile(value operatorv1beta1.SizingProfile) *DaprInstanceSpecApplyConfiguration {
+	b.Profile = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithAvailability sets the Availability field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Availability field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithAvailability(value *AvailabilitySpecApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.Availability = value
+	return b
+}
+
+// WithPlacement sets the Placement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Placement field is set to the value of the last call.
+func (b *DaprInstanceSpecApplyConfiguration) WithPlacement(value *PlacementSpecApplyConfiguration) *DaprInstanceSpecApplyConfiguration {
+	b.Placement = value
+	return b
+}
+
+// WithCommonLabels puts the entries into the CommonLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the CommonLabels field,
+// overwriting an existing map entries in CommonLabels field with the same key.
+func (b *DaprInstanceSpecApplyConfiguration) WithCommonLabels(entries map[string]string) *DaprInstanceSpecApplyConfiguration {
+	if b.CommonLabels == nil && len(entries) > 0 {
+		b.CommonLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.CommonLabels[k] = v
+	}
+	return b
+}
+
+// WithCommonAnnotations puts the entries into the CommonAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the CommonAnnotations field,
+// overwriting an existing map entries in CommonAnnotations field with the same key.
+func (b *DaprInstanceSpecApplyConfiguration) WithCommonAnnotations(entries map[string]string) *DaprInstanceSpecApplyConfiguration {
+	if b.CommonAnnotations == nil && len(entries) > 0 {
+		b.CommonAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.CommonAnnotations[k] = v
+	}
+	return b
+}
+
+// WithPodLabels puts the entries into the PodLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the PodLabels field,
+// overwriting an existing map entries in PodLabels field with the same key.
+func (b *DaprInstanceSpecApplyConfiguration) WithPodLabels(entries map[string]string) *DaprInstanceSpecApplyConfiguration {
+	if b.PodLabels == nil && len(entries) > 0 {
+		b.PodLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.PodLabels[k] = v
+	}
+	return b
+}
+
+// WithPodAnnotations puts the entries into the PodAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the PodAnnotations field,
+// overwriting an existing map entries in PodAnnotations field with the same key.
+func (b *DaprInstanceSpecApplyConfiguration) WithPodAnnotations(entries map[string]string) *DaprInstanceSpecApplyConfiguration {
+	if b.PodAnnotations == nil && len(entries) > 0 {
+		b.PodAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.PodAnnotations[k] = v
+	}
+	return b
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// JSONApplyConfiguration represents a declarative configuration of the JSON type for use
+// with apply.
+type JSONApplyConfiguration struct {
+	operatorv1beta1.RawMessage `json:",inline"`
+}
+
+// JSONApplyConfiguration constructs a declarative configuration of the JSON type for use with
+// apply.
+func JSON() *JSONApplyConfiguration {
+	return &JSONApplyConfiguration{}
+}
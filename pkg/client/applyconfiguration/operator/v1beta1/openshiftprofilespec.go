@@ -0,0 +1,38 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// OpenShiftProfileSpecApplyConfiguration represents a declarative configuration of the OpenShiftProfileSpec type for use
+// with apply.
+type OpenShiftProfileSpecApplyConfiguration struct {
+	DashboardRoute *RouteSpecApplyConfiguration `json:"dashboardRoute,omitempty"`
+}
+
+// OpenShiftProfileSpecApplyConfiguration constructs a declarative configuration of the OpenShiftProfileSpec type for use with
+// apply.
+func OpenShiftProfileSpec() *OpenShiftProfileSpecApplyConfiguration {
+	return &OpenShiftProfileSpecApplyConfiguration{}
+}
+
+// WithDashboardRoute sets the DashboardRoute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DashboardRoute field is set to the value of the last call.
+func (b *OpenShiftProfileSpecApplyConfiguration) WithDashboardRoute(value *RouteSpecApplyConfiguration) *OpenShiftProfileSpecApplyConfiguration {
+	b.DashboardRoute = value
+	return b
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// PatchApplyConfiguration represents a declarative configuration of the Patch type for use
+// with apply.
+type PatchApplyConfiguration struct {
+	Target *PatchTargetApplyConfiguration `json:"target,omitempty"`
+	Type   *operatorv1beta1.PatchType     `json:"type,omitempty"`
+	Patch  *string                        `json:"patch,omitempty"`
+}
+
+// PatchApplyConfiguration constructs a declarative configuration of the Patch type for use with
+// apply.
+func Patch() *PatchApplyConfiguration {
+	return &PatchApplyConfiguration{}
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *PatchApplyConfiguration) WithTarget(value *PatchTargetApplyConfiguration) *PatchApplyConfiguration {
+	b.Target = value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *PatchApplyConfiguration) WithType(value operatorv1beta1.PatchType) *PatchApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithPatch sets the Patch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Patch field is set to the value of the last call.
+func (b *PatchApplyConfiguration) WithPatch(value string) *PatchApplyConfiguration {
+	b.Patch = &value
+	return b
+}
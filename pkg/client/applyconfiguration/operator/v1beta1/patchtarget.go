@@ -0,0 +1,74 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PatchTargetApplyConfiguration represents a declarative configuration of the PatchTarget type for use
+// with apply.
+type PatchTargetApplyConfiguration struct {
+	Group         *string `json:"group,omitempty"`
+	Version       *string `json:"version,omitempty"`
+	Kind          *string `json:"kind,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// PatchTargetApplyConfiguration constructs a declarative configuration of the PatchTarget type for use with
+// apply.
+func PatchTarget() *PatchTargetApplyConfiguration {
+	return &PatchTargetApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *PatchTargetApplyConfiguration) WithGroup(value string) *PatchTargetApplyConfiguration {
+	b.Group = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *PatchTargetApplyConfiguration) WithVersion(value string) *PatchTargetApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *PatchTargetApplyConfiguration) WithKind(value string) *PatchTargetApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PatchTargetApplyConfiguration) WithName(value string) *PatchTargetApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *PatchTargetApplyConfiguration) WithLabelSelector(value string) *PatchTargetApplyConfiguration {
+	b.LabelSelector = &value
+	return b
+}
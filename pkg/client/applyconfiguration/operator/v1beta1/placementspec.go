@@ -0,0 +1,99 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// PlacementSpecApplyConfiguration represents a declarative configuration of the PlacementSpec type for use
+// with apply.
+type PlacementSpecApplyConfiguration struct {
+	SchedulingSpecApplyConfiguration `json:",inline"`
+	Components                       map[string]SchedulingSpecApplyConfiguration `json:"components,omitempty"`
+}
+
+// PlacementSpecApplyConfiguration constructs a declarative configuration of the PlacementSpec type for use with
+// apply.
+func PlacementSpec() *PlacementSpecApplyConfiguration {
+	return &PlacementSpecApplyConfiguration{}
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *PlacementSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *PlacementSpecApplyConfiguration {
+	if b.SchedulingSpecApplyConfiguration.NodeSelector == nil && len(entries) > 0 {
+		b.SchedulingSpecApplyConfiguration.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SchedulingSpecApplyConfiguration.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *PlacementSpecApplyConfiguration) WithTolerations(values ...v1.Toleration) *PlacementSpecApplyConfiguration {
+	for i := range values {
+		b.SchedulingSpecApplyConfiguration.Tolerations = append(b.SchedulingSpecApplyConfiguration.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *PlacementSpecApplyConfiguration) WithAffinity(value v1.Affinity) *PlacementSpecApplyConfiguration {
+	b.SchedulingSpecApplyConfiguration.Affinity = &value
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *PlacementSpecApplyConfiguration) WithTopologySpreadConstraints(values ...v1.TopologySpreadConstraint) *PlacementSpecApplyConfiguration {
+	for i := range values {
+		b.SchedulingSpecApplyConfiguration.TopologySpreadConstraints = append(b.SchedulingSpecApplyConfiguration.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *PlacementSpecApplyConfiguration) WithPriorityClassName(value string) *PlacementSpecApplyConfiguration {
+	b.SchedulingSpecApplyConfiguration.PriorityClassName = &value
+	return b
+}
+
+// WithComponents puts the entries into the Components field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Components field,
+// overwriting an existing map entries in Components field with the same key.
+func (b *PlacementSpecApplyConfiguration) WithComponents(entries map[string]SchedulingSpecApplyConfiguration) *PlacementSpecApplyConfiguration {
+	if b.Components == nil && len(entries) > 0 {
+		b.Components = make(map[string]SchedulingSpecApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Components[k] = v
+	}
+	return b
+}
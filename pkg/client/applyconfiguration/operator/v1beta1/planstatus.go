@@ -0,0 +1,83 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PlanStatusApplyConfiguration represents a declarative configuration of the PlanStatus type for use
+// with apply.
+type PlanStatusApplyConfiguration struct {
+	Hash      *string `json:"hash,omitempty"`
+	ConfigMap *string `json:"configMap,omitempty"`
+	Create    *int    `json:"create,omitempty"`
+	Update    *int    `json:"update,omitempty"`
+	Prune     *int    `json:"prune,omitempty"`
+	Approved  *bool   `json:"approved,omitempty"`
+}
+
+// PlanStatusApplyConfiguration constructs a declarative configuration of the PlanStatus type for use with
+// apply.
+func PlanStatus() *PlanStatusApplyConfiguration {
+	return &PlanStatusApplyConfiguration{}
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *PlanStatusApplyConfiguration) WithHash(value string) *PlanStatusApplyConfiguration {
+	b.Hash = &value
+	return b
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *PlanStatusApplyConfiguration) WithConfigMap(value string) *PlanStatusApplyConfiguration {
+	b.ConfigMap = &value
+	return b
+}
+
+// WithCreate sets the Create field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Create field is set to the value of the last call.
+func (b *PlanStatusApplyConfiguration) WithCreate(value int) *PlanStatusApplyConfiguration {
+	b.Create = &value
+	return b
+}
+
+// WithUpdate sets the Update field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Update field is set to the value of the last call.
+func (b *PlanStatusApplyConfiguration) WithUpdate(value int) *PlanStatusApplyConfiguration {
+	b.Update = &value
+	return b
+}
+
+// WithPrune sets the Prune field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prune field is set to the value of the last call.
+func (b *PlanStatusApplyConfiguration) WithPrune(value int) *PlanStatusApplyConfiguration {
+	b.Prune = &value
+	return b
+}
+
+// WithApproved sets the Approved field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Approved field is set to the value of the last call.
+func (b *PlanStatusApplyConfiguration) WithApproved(value bool) *PlanStatusApplyConfiguration {
+	b.Approved = &value
+	return b
+}
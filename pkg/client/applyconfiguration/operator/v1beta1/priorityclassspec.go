@@ -0,0 +1,56 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PriorityClassSpecApplyConfiguration represents a declarative configuration of the PriorityClassSpec type for use
+// with apply.
+type PriorityClassSpecApplyConfiguration struct {
+	Name        *string `json:"name,omitempty"`
+	Value       *int32  `json:"value,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// PriorityClassSpecApplyConfiguration constructs a declarative configuration of the PriorityClassSpec type for use with
+// apply.
+func PriorityClassSpec() *PriorityClassSpecApplyConfiguration {
+	return &PriorityClassSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PriorityClassSpecApplyConfiguration) WithName(value string) *PriorityClassSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *PriorityClassSpecApplyConfiguration) WithValue(value int32) *PriorityClassSpecApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *PriorityClassSpecApplyConfiguration) WithDescription(value string) *PriorityClassSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
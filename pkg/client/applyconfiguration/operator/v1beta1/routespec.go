@@ -0,0 +1,38 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// RouteSpecApplyConfiguration represents a declarative configuration of the RouteSpec type for use
+// with apply.
+type RouteSpecApplyConfiguration struct {
+	Host *string `json:"host,omitempty"`
+}
+
+// RouteSpecApplyConfiguration constructs a declarative configuration of the RouteSpec type for use with
+// apply.
+func RouteSpec() *RouteSpecApplyConfiguration {
+	return &RouteSpecApplyConfiguration{}
+}
+
+// WithHost sets the Host field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Host field is set to the value of the last call.
+func (b *RouteSpecApplyConfiguration) WithHost(value string) *RouteSpecApplyConfiguration {
+	b.Host = &value
+	return b
+}
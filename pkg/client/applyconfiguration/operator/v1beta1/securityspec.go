@@ -0,0 +1,42 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// SecuritySpecApplyConfiguration represents a declarative configuration of the SecuritySpec type for use
+// with apply.
+type SecuritySpecApplyConfiguration struct {
+	PodSecurity *operatorv1beta1.PodSecurityLevel `json:"podSecurity,omitempty"`
+}
+
+// SecuritySpecApplyConfiguration constructs a declarative configuration of the SecuritySpec type for use with
+// apply.
+func SecuritySpec() *SecuritySpecApplyConfiguration {
+	return &SecuritySpecApplyConfiguration{}
+}
+
+// WithPodSecurity sets the PodSecurity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSecurity field is set to the value of the last call.
+func (b *SecuritySpecApplyConfiguration) WithPodSecurity(value operatorv1beta1.PodSecurityLevel) *SecuritySpecApplyConfiguration {
+	b.PodSecurity = &value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// SizingStatusApplyConfiguration represents a declarative configuration of the SizingStatus type for use
+// with apply.
+type SizingStatusApplyConfiguration struct {
+	Profile   *operatorv1beta1.SizingProfile     `json:"profile,omitempty"`
+	Workloads []WorkloadSizingApplyConfiguration `json:"workloads,omitempty"`
+}
+
+// SizingStatusApplyConfiguration constructs a declarative configuration of the SizingStatus type for use with
+// apply.
+func SizingStatus() *SizingStatusApplyConfiguration {
+	return &SizingStatusApplyConfiguration{}
+}
+
+// WithProfile sets the Profile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Profile field is set to the value of the last call.
+func (b *SizingStatusApplyConfiguration) WithProfile(value operatorv1beta1.SizingProfile) *SizingStatusApplyConfiguration {
+	b.Profile = &value
+	return b
+}
+
+// WithWorkloads adds the given value to the Workloads field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workloads field.
+func (b *SizingStatusApplyConfiguration) WithWorkloads(values ...*WorkloadSizingApplyConfiguration) *SizingStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkloads")
+		}
+		b.Workloads = append(b.Workloads, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// WorkloadSizingApplyConfiguration represents a declarative configuration of the WorkloadSizing type for use
+// with apply.
+type WorkloadSizingApplyConfiguration struct {
+	Kind        *string                             `json:"kind,omitempty"`
+	Name        *string                             `json:"name,omitempty"`
+	Replicas    *int32                              `json:"replicas,omitempty"`
+	Containers  []ContainerSizingApplyConfiguration `json:"containers,omitempty"`
+	StorageSize map[string]resource.Quantity        `json:"storageSize,omitempty"`
+}
+
+// WorkloadSizingApplyConfiguration constructs a declarative configuration of the WorkloadSizing type for use with
+// apply.
+func WorkloadSizing() *WorkloadSizingApplyConfiguration {
+	return &WorkloadSizingApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *WorkloadSizingApplyConfiguration) WithKind(value string) *WorkloadSizingApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *WorkloadSizingApplyConfiguration) WithName(value string) *WorkloadSizingApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *WorkloadSizingApplyConfiguration) WithReplicas(value int32) *WorkloadSizingApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *WorkloadSizingApplyConfiguration) WithContainers(values ...*ContainerSizingApplyConfiguration) *WorkloadSizingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithContainers")
+		}
+		b.Containers = append(b.Containers, *values[i])
+	}
+	return b
+}
+
+// WithStorageSize puts the entries into the StorageSize field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the StorageSize field,
+// overwriting an existing map entries in StorageSize field with the same key.
+func (b *WorkloadSizingApplyConfiguration) WithStorageSize(entries map[string]resource.Quantity) *WorkloadSizingApplyConfiguration {
+	if b.StorageSize == nil && len(entries) > 0 {
+		b.StorageSize = make(map[string]resource.Quantity, len(entries))
+	}
+	for k, v := range entries {
+		b.StorageSize[k] = v
+	}
+	return b
+}
@@ -19,8 +19,10 @@ package applyconfiguration
 
 import (
 	v1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	v1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	internal "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/internal"
 	operatorv1alpha1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1alpha1"
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
@@ -35,6 +37,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &operatorv1alpha1.ChartMetaApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ChartSpec"):
 		return &operatorv1alpha1.ChartSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ChartUpdate"):
+		return &operatorv1alpha1.ChartUpdateApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DaprControlPlane"):
 		return &operatorv1alpha1.DaprControlPlaneApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DaprControlPlaneSpec"):
@@ -53,9 +57,75 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &operatorv1alpha1.DaprInstanceStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JSON"):
 		return &operatorv1alpha1.JSONApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &operatorv1alpha1.MaintenanceWindowApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PlanStatus"):
+		return &operatorv1alpha1.PlanStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Status"):
 		return &operatorv1alpha1.StatusApplyConfiguration{}
 
+		// Group=operator.dapr.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("AutoscalingSpec"):
+		return &operatorv1beta1.AutoscalingSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AvailabilitySpec"):
+		return &operatorv1beta1.AvailabilitySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChartMeta"):
+		return &operatorv1beta1.ChartMetaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChartSpec"):
+		return &operatorv1beta1.ChartSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChartUpdate"):
+		return &operatorv1beta1.ChartUpdateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterProfileSpec"):
+		return &operatorv1beta1.ClusterProfileSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ComponentAutoscalingSpec"):
+		return &operatorv1beta1.ComponentAutoscalingSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContainerSizing"):
+		return &operatorv1beta1.ContainerSizingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprControlPlane"):
+		return &operatorv1beta1.DaprControlPlaneApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprControlPlaneSpec"):
+		return &operatorv1beta1.DaprControlPlaneSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprControlPlaneStatus"):
+		return &operatorv1beta1.DaprControlPlaneStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprCruiseControl"):
+		return &operatorv1beta1.DaprCruiseControlApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprCruiseControlStatus"):
+		return &operatorv1beta1.DaprCruiseControlStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprInstance"):
+		return &operatorv1beta1.DaprInstanceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprInstanceSpec"):
+		return &operatorv1beta1.DaprInstanceSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DaprInstanceStatus"):
+		return &operatorv1beta1.DaprInstanceStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JSON"):
+		return &operatorv1beta1.JSONApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &operatorv1beta1.MaintenanceWindowApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OpenShiftProfileSpec"):
+		return &operatorv1beta1.OpenShiftProfileSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Patch"):
+		return &operatorv1beta1.PatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PatchTarget"):
+		return &operatorv1beta1.PatchTargetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PlacementSpec"):
+		return &operatorv1beta1.PlacementSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PlanStatus"):
+		return &operatorv1beta1.PlanStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PriorityClassSpec"):
+		return &operatorv1beta1.PriorityClassSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RouteSpec"):
+		return &operatorv1beta1.RouteSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SchedulingSpec"):
+		return &operatorv1beta1.SchedulingSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SecuritySpec"):
+		return &operatorv1beta1.SecuritySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SizingStatus"):
+		return &operatorv1beta1.SizingStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Status"):
+		return &operatorv1beta1.StatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkloadSizing"):
+		return &operatorv1beta1.WorkloadSizingApplyConfiguration{}
+
 	}
 	return nil
 }
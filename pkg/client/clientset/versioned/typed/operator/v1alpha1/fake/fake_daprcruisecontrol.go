@@ -0,0 +1,52 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	operatorv1alpha1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1alpha1"
+	typedoperatorv1alpha1 "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned/typed/operator/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeDaprCruiseControls implements DaprCruiseControlInterface
+type fakeDaprCruiseControls struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.DaprCruiseControl, *v1alpha1.DaprCruiseControlList, *operatorv1alpha1.DaprCruiseControlApplyConfiguration]
+	Fake *FakeOperatorV1alpha1
+}
+
+func newFakeDaprCruiseControls(fake *FakeOperatorV1alpha1, namespace string) typedoperatorv1alpha1.DaprCruiseControlInterface {
+	return &fakeDaprCruiseControls{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.DaprCruiseControl, *v1alpha1.DaprCruiseControlList, *operatorv1alpha1.DaprCruiseControlApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("daprcruisecontrols"),
+			v1alpha1.SchemeGroupVersion.WithKind("DaprCruiseControl"),
+			func() *v1alpha1.DaprCruiseControl { return &v1alpha1.DaprCruiseControl{} },
+			func() *v1alpha1.DaprCruiseControlList { return &v1alpha1.DaprCruiseControlList{} },
+			func(dst, src *v1alpha1.DaprCruiseControlList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.DaprCruiseControlList) []*v1alpha1.DaprCruiseControl {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.DaprCruiseControlList, items []*v1alpha1.DaprCruiseControl) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
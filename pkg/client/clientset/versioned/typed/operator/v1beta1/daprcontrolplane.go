@@ -0,0 +1,73 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	applyconfigurationoperatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+	scheme "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// DaprControlPlanesGetter has a method to return a DaprControlPlaneInterface.
+// A group's client should implement this interface.
+type DaprControlPlanesGetter interface {
+	DaprControlPlanes(namespace string) DaprControlPlaneInterface
+}
+
+// DaprControlPlaneInterface has methods to work with DaprControlPlane resources.
+type DaprControlPlaneInterface interface {
+	Create(ctx context.Context, daprControlPlane *operatorv1beta1.DaprControlPlane, opts v1.CreateOptions) (*operatorv1beta1.DaprControlPlane, error)
+	Update(ctx context.Context, daprControlPlane *operatorv1beta1.DaprControlPlane, opts v1.UpdateOptions) (*operatorv1beta1.DaprControlPlane, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, daprControlPlane *operatorv1beta1.DaprControlPlane, opts v1.UpdateOptions) (*operatorv1beta1.DaprControlPlane, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*operatorv1beta1.DaprControlPlane, error)
+	List(ctx context.Context, opts v1.ListOptions) (*operatorv1beta1.DaprControlPlaneList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *operatorv1beta1.DaprControlPlane, err error)
+	Apply(ctx context.Context, daprControlPlane *applyconfigurationoperatorv1beta1.DaprControlPlaneApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.DaprControlPlane, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, daprControlPlane *applyconfigurationoperatorv1beta1.DaprControlPlaneApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.DaprControlPlane, err error)
+	DaprControlPlaneExpansion
+}
+
+// daprControlPlanes implements DaprControlPlaneInterface
+type daprControlPlanes struct {
+	*gentype.ClientWithListAndApply[*operatorv1beta1.DaprControlPlane, *operatorv1beta1.DaprControlPlaneList, *applyconfigurationoperatorv1beta1.DaprControlPlaneApplyConfiguration]
+}
+
+// newDaprControlPlanes returns a DaprControlPlanes
+func newDaprControlPlanes(c *OperatorV1beta1Client, namespace string) *daprControlPlanes {
+	return &daprControlPlanes{
+		gentype.NewClientWithListAndApply[*operatorv1beta1.DaprControlPlane, *operatorv1beta1.DaprControlPlaneList, *applyconfigurationoperatorv1beta1.DaprControlPlaneApplyConfiguration](
+			"daprcontrolplanes",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *operatorv1beta1.DaprControlPlane { return &operatorv1beta1.DaprControlPlane{} },
+			func() *operatorv1beta1.DaprControlPlaneList { return &operatorv1beta1.DaprControlPlaneList{} },
+		),
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	applyconfigurationoperatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+	scheme "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// DaprInstancesGetter has a method to return a DaprInstanceInterface.
+// A group's client should implement this interface.
+type DaprInstancesGetter interface {
+	DaprInstances(namespace string) DaprInstanceInterface
+}
+
+// DaprInstanceInterface has methods to work with DaprInstance resources.
+type DaprInstanceInterface interface {
+	Create(ctx context.Context, daprInstance *operatorv1beta1.DaprInstance, opts v1.CreateOptions) (*operatorv1beta1.DaprInstance, error)
+	Update(ctx context.Context, daprInstance *operatorv1beta1.DaprInstance, opts v1.UpdateOptions) (*operatorv1beta1.DaprInstance, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, daprInstance *operatorv1beta1.DaprInstance, opts v1.UpdateOptions) (*operatorv1beta1.DaprInstance, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*operatorv1beta1.DaprInstance, error)
+	List(ctx context.Context, opts v1.ListOptions) (*operatorv1beta1.DaprInstanceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *operatorv1beta1.DaprInstance, err error)
+	Apply(ctx context.Context, daprInstance *applyconfigurationoperatorv1beta1.DaprInstanceApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.DaprInstance, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, daprInstance *applyconfigurationoperatorv1beta1.DaprInstanceApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.DaprInstance, err error)
+	DaprInstanceExpansion
+}
+
+// daprInstances implements DaprInstanceInterface
+type daprInstances struct {
+	*gentype.ClientWithListAndApply[*operatorv1beta1.DaprInstance, *operatorv1beta1.DaprInstanceList, *applyconfigurationoperatorv1beta1.DaprInstanceApplyConfiguration]
+}
+
+// newDaprInstances returns a DaprInstances
+func newDaprInstances(c *OperatorV1beta1Client, namespace string) *daprInstances {
+	return &daprInstances{
+		gentype.NewClientWithListAndApply[*operatorv1beta1.DaprInstance, *operatorv1beta1.DaprInstanceList, *applyconfigurationoperatorv1beta1.DaprInstanceApplyConfiguration](
+			"daprinstances",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *operatorv1beta1.DaprInstance { return &operatorv1beta1.DaprInstance{} },
+			func() *operatorv1beta1.DaprInstanceList { return &operatorv1beta1.DaprInstanceList{} },
+		),
+	}
+}
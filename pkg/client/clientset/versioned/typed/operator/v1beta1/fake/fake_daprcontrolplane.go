@@ -0,0 +1,52 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+	typedoperatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned/typed/operator/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeDaprControlPlanes implements DaprControlPlaneInterface
+type fakeDaprControlPlanes struct {
+	*gentype.FakeClientWithListAndApply[*v1beta1.DaprControlPlane, *v1beta1.DaprControlPlaneList, *operatorv1beta1.DaprControlPlaneApplyConfiguration]
+	Fake *FakeOperatorV1beta1
+}
+
+func newFakeDaprControlPlanes(fake *FakeOperatorV1beta1, namespace string) typedoperatorv1beta1.DaprControlPlaneInterface {
+	return &fakeDaprControlPlanes{
+		gentype.NewFakeClientWithListAndApply[*v1beta1.DaprControlPlane, *v1beta1.DaprControlPlaneList, *operatorv1beta1.DaprControlPlaneApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("daprcontrolplanes"),
+			v1beta1.SchemeGroupVersion.WithKind("DaprControlPlane"),
+			func() *v1beta1.DaprControlPlane { return &v1beta1.DaprControlPlane{} },
+			func() *v1beta1.DaprControlPlaneList { return &v1beta1.DaprControlPlaneList{} },
+			func(dst, src *v1beta1.DaprControlPlaneList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.DaprControlPlaneList) []*v1beta1.DaprControlPlane {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.DaprControlPlaneList, items []*v1beta1.DaprControlPlane) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
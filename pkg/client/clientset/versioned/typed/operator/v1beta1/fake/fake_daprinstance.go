@@ -0,0 +1,52 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+	typedoperatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned/typed/operator/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeDaprInstances implements DaprInstanceInterface
+type fakeDaprInstances struct {
+	*gentype.FakeClientWithListAndApply[*v1beta1.DaprInstance, *v1beta1.DaprInstanceList, *operatorv1beta1.DaprInstanceApplyConfiguration]
+	Fake *FakeOperatorV1beta1
+}
+
+func newFakeDaprInstances(fake *FakeOperatorV1beta1, namespace string) typedoperatorv1beta1.DaprInstanceInterface {
+	return &fakeDaprInstances{
+		gentype.NewFakeClientWithListAndApply[*v1beta1.DaprInstance, *v1beta1.DaprInstanceList, *operatorv1beta1.DaprInstanceApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("daprinstances"),
+			v1beta1.SchemeGroupVersion.WithKind("DaprInstance"),
+			func() *v1beta1.DaprInstance { return &v1beta1.DaprInstance{} },
+			func() *v1beta1.DaprInstanceList { return &v1beta1.DaprInstanceList{} },
+			func(dst, src *v1beta1.DaprInstanceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.DaprInstanceList) []*v1beta1.DaprInstance {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.DaprInstanceList, items []*v1beta1.DaprInstance) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
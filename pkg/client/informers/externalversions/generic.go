@@ -21,6 +21,7 @@ import (
 	fmt "fmt"
 
 	v1alpha1 "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	v1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -59,6 +60,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("daprinstances"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1alpha1().DaprInstances().Informer()}, nil
 
+		// Group=operator.dapr.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("daprcontrolplanes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().DaprControlPlanes().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("daprcruisecontrols"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().DaprCruiseControls().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("daprinstances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operator().V1beta1().DaprInstances().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,101 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+	time "time"
+
+	apioperatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	versioned "github.com/dapr/kubernetes-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/dapr/kubernetes-operator/pkg/client/informers/externalversions/internalinterfaces"
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/pkg/client/listers/operator/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// DaprCruiseControlInformer provides access to a shared informer and lister for
+// DaprCruiseControls.
+type DaprCruiseControlInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() operatorv1beta1.DaprCruiseControlLister
+}
+
+type daprCruiseControlInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewDaprCruiseControlInformer constructs a new informer for DaprCruiseControl type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewDaprCruiseControlInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredDaprCruiseControlInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredDaprCruiseControlInformer constructs a new informer for DaprCruiseControl type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredDaprCruiseControlInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperatorV1beta1().DaprCruiseControls(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperatorV1beta1().DaprCruiseControls(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperatorV1beta1().DaprCruiseControls(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OperatorV1beta1().DaprCruiseControls(namespace).Watch(ctx, options)
+			},
+		},
+		&apioperatorv1beta1.DaprCruiseControl{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *daprCruiseControlInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredDaprCruiseControlInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *daprCruiseControlInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apioperatorv1beta1.DaprCruiseControl{}, f.defaultInformer)
+}
+
+func (f *daprCruiseControlInformer) Lister() operatorv1beta1.DaprCruiseControlLister {
+	return operatorv1beta1.NewDaprCruiseControlLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// DaprControlPlaneLister helps list DaprControlPlanes.
+// All objects returned here must be treated as read-only.
+type DaprControlPlaneLister interface {
+	// List lists all DaprControlPlanes in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*operatorv1beta1.DaprControlPlane, err error)
+	// DaprControlPlanes returns an object that can list and get DaprControlPlanes.
+	DaprControlPlanes(namespace string) DaprControlPlaneNamespaceLister
+	DaprControlPlaneListerExpansion
+}
+
+// daprControlPlaneLister implements the DaprControlPlaneLister interface.
+type daprControlPlaneLister struct {
+	listers.ResourceIndexer[*operatorv1beta1.DaprControlPlane]
+}
+
+// NewDaprControlPlaneLister returns a new DaprControlPlaneLister.
+func NewDaprControlPlaneLister(indexer cache.Indexer) DaprControlPlaneLister {
+	return &daprControlPlaneLister{listers.New[*operatorv1beta1.DaprControlPlane](indexer, operatorv1beta1.Resource("daprcontrolplane"))}
+}
+
+// DaprControlPlanes returns an object that can list and get DaprControlPlanes.
+func (s *daprControlPlaneLister) DaprControlPlanes(namespace string) DaprControlPlaneNamespaceLister {
+	return daprControlPlaneNamespaceLister{listers.NewNamespaced[*operatorv1beta1.DaprControlPlane](s.ResourceIndexer, namespace)}
+}
+
+// DaprControlPlaneNamespaceLister helps list and get DaprControlPlanes.
+// All objects returned here must be treated as read-only.
+type DaprControlPlaneNamespaceLister interface {
+	// List lists all DaprControlPlanes in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*operatorv1beta1.DaprControlPlane, err error)
+	// Get retrieves the DaprControlPlane from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*operatorv1beta1.DaprControlPlane, error)
+	DaprControlPlaneNamespaceListerExpansion
+}
+
+// daprControlPlaneNamespaceLister implements the DaprControlPlaneNamespaceLister
+// interface.
+type daprControlPlaneNamespaceLister struct {
+	listers.ResourceIndexer[*operatorv1beta1.DaprControlPlane]
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	operatorv1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// DaprCruiseControlLister helps list DaprCruiseControls.
+// All objects returned here must be treated as read-only.
+type DaprCruiseControlLister interface {
+	// List lists all DaprCruiseControls in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*operatorv1beta1.DaprCruiseControl, err error)
+	// DaprCruiseControls returns an object that can list and get DaprCruiseControls.
+	DaprCruiseControls(namespace string) DaprCruiseControlNamespaceLister
+	DaprCruiseControlListerExpansion
+}
+
+// daprCruiseControlLister implements the DaprCruiseControlLister interface.
+type daprCruiseControlLister struct {
+	listers.ResourceIndexer[*operatorv1beta1.DaprCruiseControl]
+}
+
+// NewDaprCruiseControlLister returns a new DaprCruiseControlLister.
+func NewDaprCruiseControlLister(indexer cache.Indexer) DaprCruiseControlLister {
+	return &daprCruiseControlLister{listers.New[*operatorv1beta1.DaprCruiseControl](indexer, operatorv1beta1.Resource("daprcruisecontrol"))}
+}
+
+// DaprCruiseControls returns an object that can list and get DaprCruiseControls.
+func (s *daprCruiseControlLister) DaprCruiseControls(namespace string) DaprCruiseControlNamespaceLister {
+	return daprCruiseControlNamespaceLister{listers.NewNamespaced[*operatorv1beta1.DaprCruiseControl](s.ResourceIndexer, namespace)}
+}
+
+// DaprCruiseControlNamespaceLister helps list and get DaprCruiseControls.
+// All objects returned here must be treated as read-only.
+type DaprCruiseControlNamespaceLister interface {
+	// List lists all DaprCruiseControls in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*operatorv1beta1.DaprCruiseControl, err error)
+	// Get retrieves the DaprCruiseControl from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*operatorv1beta1.DaprCruiseControl, error)
+	DaprCruiseControlNamespaceListerExpansion
+}
+
+// daprCruiseControlNamespaceLister implements the DaprCruiseControlNamespaceLister
+// interface.
+type daprCruiseControlNamespaceLister struct {
+	listers.ResourceIndexer[*operatorv1beta1.DaprCruiseControl]
+}
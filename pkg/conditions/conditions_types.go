@@ -4,8 +4,46 @@ const (
 	TypeReconciled                 = "Reconciled"
 	TypeReady                      = "Ready"
 	TypeError                      = "Error"
+	TypeProgressing                = "Progressing"
+	TypePending                    = "Pending"
+	TypeUpgradeBlocked             = "UpgradeBlocked"
+	TypeMigrated                   = "Migrated"
+	TypeValuesValid                = "ValuesValid"
+	TypePodSecurityCompliant       = "PodSecurityCompliant"
+	TypePatchesApplied             = "PatchesApplied"
+	TypeChartResolved              = "ChartResolved"
+	TypeChartUpdatesChecked        = "ChartUpdatesChecked"
 	ReasonReady                    = "Ready"
 	ReasonReconciled               = "Ready"
 	ReasonFailure                  = "Failure"
 	ReasonUnsupportedConfiguration = "UnsupportedConfiguration"
+	ReasonUpgrading                = "Upgrading"
+	ReasonUpgradeCompleted         = "UpgradeCompleted"
+	ReasonUpToDate                 = "UpToDate"
+	ReasonUpgradeAllowed           = "UpgradeAllowed"
+	ReasonUpgradeOverridden        = "UpgradeOverridden"
+	ReasonUnsupportedUpgrade       = "UnsupportedUpgrade"
+	ReasonNoPendingChanges         = "NoPendingChanges"
+	ReasonInMaintenanceWindow      = "InMaintenanceWindow"
+	ReasonOutsideMaintenanceWindow = "OutsideMaintenanceWindow"
+	ReasonMaintenanceOverridden    = "MaintenanceOverridden"
+	ReasonAwaitingApproval         = "AwaitingApproval"
+	ReasonPlanApproved             = "PlanApproved"
+	ReasonMigrationPending         = "MigrationPending"
+	ReasonMigrationDisabled        = "MigrationDisabled"
+	ReasonMigrated                 = "Migrated"
+	ReasonMigratedSpecIgnored      = "MigratedSpecIgnored"
+	ReasonValidValues              = "ValidValues"
+	ReasonUnknownValues            = "UnknownValues"
+	ReasonInvalidValues            = "InvalidValues"
+	ReasonInvalidValuesTransform   = "InvalidValuesTransform"
+	ReasonPodSecurityCompliant     = "PodSecurityCompliant"
+	ReasonPodSecurityViolation     = "PodSecurityViolation"
+	ReasonPatchesApplied           = "PatchesApplied"
+	ReasonUnmatchedPatches         = "UnmatchedPatches"
+	ReasonInvalidPatches           = "InvalidPatches"
+	ReasonNoConversionWebhook      = "NoConversionWebhook"
+	ReasonChartResolved            = "ChartResolved"
+	ReasonRepositoryUnavailable    = "RepositoryUnavailable"
+	ReasonChartUpdatesChecked      = "ChartUpdatesChecked"
 )
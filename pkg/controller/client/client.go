@@ -41,7 +41,7 @@ type Client struct {
 	Dapr      daprClient.Interface
 	Discovery discovery.DiscoveryInterface
 
-	dynamic          *dynamic.DynamicClient
+	dynamic          dynamic.Interface
 	scheme           *runtime.Scheme
 	config           *rest.Config
 	rest             rest.Interface
@@ -100,6 +100,26 @@ func NewClient(cfg *rest.Config, scheme *runtime.Scheme, cc ctrl.Client) (*Clien
 	return &c, nil
 }
 
+// NewClientFor returns a Client out of the given clients, i.e. to run against fake clients.
+// The resources are mapped with the given REST mapper, while the discovery of the resources
+// to garbage collect is performed with the given Kubernetes client.
+func NewClientFor(
+	scheme *runtime.Scheme,
+	cc ctrl.Client,
+	kc kubernetes.Interface,
+	dc dynamic.Interface,
+	mapper meta.RESTMapper,
+) *Client {
+	return &Client{
+		Client:    cc,
+		Interface: kc,
+		Discovery: kc.Discovery(),
+		dynamic:   dc,
+		scheme:    scheme,
+		mapper:    mapper,
+	}
+}
+
 func newRESTClientForConfig(config *rest.Config) (*rest.RESTClient, error) {
 	cfg := rest.CopyConfig(config)
 	// so that the RESTClientFor doesn't complain
@@ -119,11 +139,13 @@ func newRESTClientForConfig(config *rest.Config) (*rest.RESTClient, error) {
 }
 
 func (c *Client) Dynamic(namespace string, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
-	if c.discoveryLimiter.Allow() {
-		c.discoveryCache.Invalidate()
-	}
+	if c.discoveryCache != nil {
+		if c.discoveryLimiter.Allow() {
+			c.discoveryCache.Invalidate()
+		}
 
-	c.discoveryCache.Fresh()
+		c.discoveryCache.Fresh()
+	}
 
 	mapping, err := c.mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
 	if err != nil {
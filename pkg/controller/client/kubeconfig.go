@@ -0,0 +1,44 @@
+package client
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClientForKubeconfig creates a client for the given kubeconfig file and context, the
+// default loading rules apply when they are not set. The namespace of the selected context
+// is returned too.
+func NewClientForKubeconfig(kubeconfig string, context string, scheme *runtime.Scheme) (*Client, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+
+	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		rules,
+		&clientcmd.ConfigOverrides{CurrentContext: context},
+	)
+
+	namespace, _, err := cc.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to determine namespace: %w", err)
+	}
+
+	cfg, err := cc.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to load kubeconfig: %w", err)
+	}
+
+	cl, err := ctrl.New(cfg, ctrl.Options{Scheme: scheme})
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to create client: %w", err)
+	}
+
+	c, err := NewClient(cfg, scheme, cl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return c, namespace, nil
+}
@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/dapr/kubernetes-operator/pkg/logger"
 )
@@ -57,6 +58,10 @@ func Start(options Options, setup func(manager.Manager, Options) error) error {
 		Cache: cache.Options{
 			ByObject: options.WatchSelectors,
 		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    options.WebhookPort,
+			CertDir: options.WebhookCertDir,
+		}),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to create manager: %w", err)
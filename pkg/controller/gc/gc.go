@@ -58,6 +58,45 @@ func (gc *GC) Run(
 	return gc.deleteEachOf(ctx, c, selector, predicate)
 }
 
+// List returns the resources matching the given selector and predicate, which are the
+// resources that would be deleted by Run.
+func (gc *GC) List(
+	ctx context.Context,
+	c *client.Client,
+	ns string,
+	selector labels.Selector,
+	predicate func(context.Context, unstructured.Unstructured) (bool, error),
+) ([]unstructured.Unstructured, error) {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+
+	err := gc.computeDeletableTypes(ctx, c, ns)
+	if err != nil {
+		return nil, fmt.Errorf("cannot discover GVK types: %w", err)
+	}
+
+	answer := make([]unstructured.Unstructured, 0)
+
+	err = gc.eachOf(ctx, c, selector, func(resource unstructured.Unstructured) error {
+		if !gc.canBeDeleted(ctx, resource.GroupVersionKind()) {
+			return nil
+		}
+
+		ok, err := predicate(ctx, resource)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			answer = append(answer, resource)
+		}
+
+		return nil
+	})
+
+	return answer, err
+}
+
 func (gc *GC) deleteEachOf(
 	ctx context.Context,
 	c *client.Client,
@@ -66,6 +105,31 @@ func (gc *GC) deleteEachOf(
 ) (int, error) {
 	deleted := 0
 
+	err := gc.eachOf(ctx, c, selector, func(resource unstructured.Unstructured) error {
+		ok, err := gc.delete(ctx, c, resource, predicate)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			deleted++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+func (gc *GC) eachOf(
+	ctx context.Context,
+	c *client.Client,
+	selector labels.Selector,
+	fn func(unstructured.Unstructured) error,
+) error {
 	for _, GVK := range gc.collectableGVKs {
 		items := unstructured.UnstructuredList{
 			Object: map[string]interface{}{
@@ -85,25 +149,20 @@ func (gc *GC) deleteEachOf(
 			}
 
 			if !k8serrors.IsNotFound(err) {
-				return 0, fmt.Errorf("cannot list child resources %s: %w", GVK.String(), err)
+				return fmt.Errorf("cannot list child resources %s: %w", GVK.String(), err)
 			}
 
 			continue
 		}
 
 		for i := range items.Items {
-			ok, err := gc.delete(ctx, c, items.Items[i], predicate)
-			if err != nil {
-				return 0, err
-			}
-
-			if ok {
-				deleted++
+			if err := fn(items.Items[i]); err != nil {
+				return err
 			}
 		}
 	}
 
-	return deleted, nil
+	return nil
 }
 
 func (gc *GC) delete(
@@ -32,21 +32,13 @@ func (p AnnotationChanged) Update(e event.UpdateEvent) bool {
 		return false
 	}
 
-	if e.ObjectOld.GetAnnotations() == nil {
-		log.Error(nil, "Update event has no old object annotations to update", "event", e)
-		return false
-	}
-
 	if e.ObjectNew == nil {
 		log.Error(nil, "Update event has no new object for update", "event", e)
 		return false
 	}
 
-	if e.ObjectNew.GetAnnotations() == nil {
-		log.Error(nil, "Update event has no new object annotations for update", "event", e)
-		return false
-	}
-
+	// annotations may be added to or removed from an object that had none, reading
+	// from a nil map is fine as it results in the annotation being unset
 	oldAnnotations := e.ObjectOld.GetAnnotations()
 	newAnnotations := e.ObjectNew.GetAnnotations()
 
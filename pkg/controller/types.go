@@ -1,7 +1,7 @@
 package controller
 
 import (
-	daptCtrlApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daptCtrlApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	rtcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	rtclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -25,6 +25,9 @@ type Options struct {
 	LeaderElectionNamespace       string
 	EnableLeaderElection          bool
 	ReleaseLeaderElectionOnCancel bool
+	EnableWebhooks                bool
+	WebhookPort                   int
+	WebhookCertDir                string
 	WatchSelectors                map[rtclient.Object]rtcache.ByObject
 }
 
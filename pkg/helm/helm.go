@@ -1,7 +1,7 @@
 package helm
 
 import (
-	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	helme "github.com/lburgazzoli/k8s-manifests-renderer-helm/engine"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -0,0 +1,24 @@
+package helm
+
+import (
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// ReleaseManagedBy is the label identifying the manager of the Helm release records.
+	ReleaseManagedBy = "app.kubernetes.io/managed-by"
+
+	// ReleaseMaxHistory is the maximum number of Helm release records retained.
+	ReleaseMaxHistory = 10
+)
+
+// ReleaseStorage returns the storage of the Helm release records backed by the given
+// Secrets, which is the default storage driver used by Helm.
+func ReleaseStorage(secrets corev1.SecretInterface) *storage.Storage {
+	s := storage.Init(driver.NewSecrets(secrets))
+	s.MaxHistory = ReleaseMaxHistory
+
+	return s
+}
@@ -0,0 +1,128 @@
+package helm_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strconv"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+func helmRelease(version int, status release.Status) *release.Release {
+	return &release.Release{
+		Name:      "dapr-instance",
+		Namespace: "dapr-system",
+		Version:   version,
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "dapr", Version: "1.16.1"},
+		},
+		Config:   map[string]interface{}{"global": map[string]interface{}{"logAsJson": true}},
+		Manifest: "---\napiVersion: v1\nkind: Service\nmetadata:\n  name: dapr-api\n",
+		Info:     &release.Info{Status: status},
+		Labels:   map[string]string{helm.ReleaseManagedBy: "dapr-kubernetes-controller"},
+	}
+}
+
+// decode decodes the release stored in the given Secret as Helm does, that is a base64
+// encoded gzipped JSON document.
+func decode(t *testing.T, secret *corev1.Secret) *release.Release {
+	t.Helper()
+
+	data, err := base64.StdEncoding.DecodeString(string(secret.Data["release"]))
+	if err != nil {
+		t.Fatalf("invalid release encoding: %v", err)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("invalid release compression: %v", err)
+	}
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("invalid release compression: %v", err)
+	}
+
+	rls := release.Release{}
+	if err := json.Unmarshal(content, &rls); err != nil {
+		t.Fatalf("invalid release: %v", err)
+	}
+
+	return &rls
+}
+
+func TestReleaseStorageFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	kc := kubeFake.NewClientset()
+	s := helm.ReleaseStorage(kc.CoreV1().Secrets("dapr-system"))
+
+	g.Expect(s.Create(helmRelease(1, release.StatusDeployed))).To(Succeed())
+
+	secret, err := kc.CoreV1().Secrets("dapr-system").Get(t.Context(), "sh.helm.release.v1.dapr-instance.v1", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(secret.Type).To(Equal(corev1.SecretType("helm.sh/release.v1")))
+	g.Expect(secret.Labels).To(And(
+		HaveKeyWithValue("name", "dapr-instance"),
+		HaveKeyWithValue("owner", "helm"),
+		HaveKeyWithValue("status", "deployed"),
+		HaveKeyWithValue("version", "1"),
+		HaveKeyWithValue(helm.ReleaseManagedBy, "dapr-kubernetes-controller"),
+	))
+
+	rls := decode(t, secret)
+	g.Expect(rls.Name).To(Equal("dapr-instance"))
+	g.Expect(rls.Version).To(Equal(1))
+	g.Expect(rls.Info.Status).To(Equal(release.StatusDeployed))
+	g.Expect(rls.Chart.Metadata.Version).To(Equal("1.16.1"))
+	g.Expect(rls.Config).To(HaveKeyWithValue("global", HaveKeyWithValue("logAsJson", true)))
+	g.Expect(rls.Manifest).To(ContainSubstring("name: dapr-api"))
+
+	// the status of the release is reflected by the record
+	last, err := s.Last("dapr-instance")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	last.SetStatus(release.StatusFailed, "failed")
+	g.Expect(s.Update(last)).To(Succeed())
+
+	secret, err = kc.CoreV1().Secrets("dapr-system").Get(t.Context(), "sh.helm.release.v1.dapr-instance.v1", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(secret.Labels).To(HaveKeyWithValue("status", "failed"))
+	g.Expect(decode(t, secret).Info.Status).To(Equal(release.StatusFailed))
+}
+
+func TestReleaseStorageMaxHistory(t *testing.T) {
+	g := NewWithT(t)
+
+	kc := kubeFake.NewClientset()
+	s := helm.ReleaseStorage(kc.CoreV1().Secrets("dapr-system"))
+
+	for v := 1; v <= helm.ReleaseMaxHistory+2; v++ {
+		g.Expect(s.Create(helmRelease(v, release.StatusSuperseded))).To(Succeed())
+	}
+
+	history, err := s.History("dapr-instance")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(history).To(HaveLen(helm.ReleaseMaxHistory))
+
+	versions := make([]string, 0, len(history))
+	for _, r := range history {
+		versions = append(versions, strconv.Itoa(r.Version))
+	}
+
+	// the oldest records are removed
+	g.Expect(versions).ToNot(ContainElements("1", "2"))
+	g.Expect(versions).To(ContainElement(strconv.Itoa(helm.ReleaseMaxHistory + 2)))
+}
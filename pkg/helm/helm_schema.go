@@ -0,0 +1,292 @@
+package helm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+)
+
+const (
+	schemaRootURL    = "file:///values.schema.json"
+	schemaCuratedURL = "file:///curated.schema.json"
+)
+
+var ErrInvalidValues = errors.New("invalid values")
+
+// LoadChart loads the chart with the given name and version, either from a local directory
+// or from the given repository.
+func LoadChart(repoURL string, name string, version string, opts RepositoryOptions) (*chart.Chart, error) {
+	po := action.ChartPathOptions{
+		RepoURL:  repoURL,
+		Version:  version,
+		Username: opts.Username,
+		Password: opts.Password,
+	}
+
+	path, err := po.LocateChart(name, cli.New())
+	if err != nil {
+		return nil, fmt.Errorf("unable to locate chart (repo: %s, name: %s, version: %s): %w", repoURL, name, version, err)
+	}
+
+	c, err := loader.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load chart (repo: %s, name: %s, version: %s): %w", repoURL, name, version, err)
+	}
+
+	return c, nil
+}
+
+// CoalesceValues merges the given values with the default values of the chart and of its
+// subcharts, as Helm does when rendering. The result is made of the types produced by the
+// JSON decoder, so it can be processed by jq expressions and by the schema validator.
+func CoalesceValues(c *chart.Chart, values map[string]interface{}) (map[string]interface{}, error) {
+	coalesced, err := chartutil.CoalesceValues(c, values)
+	if err != nil {
+		return nil, fmt.Errorf("unable to coalesce values of chart %s: %w", c.Name(), err)
+	}
+
+	data, err := json.Marshal(coalesced)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode values: %w", err)
+	}
+
+	answer := make(map[string]interface{})
+
+	if err := json.Unmarshal(data, &answer); err != nil {
+		return nil, fmt.Errorf("unable to decode values: %w", err)
+	}
+
+	return answer, nil
+}
+
+// ValuesValidator validates chart values against the values.schema.json shipped by a chart
+// and by its subcharts, combined with an additional schema.
+type ValuesValidator struct {
+	schema *jsonschema.Schema
+	known  map[string]struct{}
+}
+
+// NewValuesValidator creates a ValuesValidator for the given chart. The schema of each
+// subchart applies to the values nested under the name of the subchart, the additional
+// schema applies to the whole values and can be empty.
+func NewValuesValidator(c *chart.Chart, additional []byte) (*ValuesValidator, error) {
+	compiler := jsonschema.NewCompiler()
+
+	combined := make([]any, 0)
+	subcharts := make(map[string]any)
+
+	v := ValuesValidator{
+		known: map[string]struct{}{
+			"global": {},
+		},
+	}
+
+	for k := range c.Values {
+		v.known[k] = struct{}{}
+	}
+
+	if len(c.Schema) > 0 {
+		if err := addSchema(compiler, schemaRootURL, c.Schema); err != nil {
+			return nil, err
+		}
+
+		combined = append(combined, map[string]any{"$ref": schemaRootURL})
+	}
+
+	if len(additional) > 0 {
+		if err := addSchema(compiler, schemaCuratedURL, additional); err != nil {
+			return nil, err
+		}
+
+		combined = append(combined, map[string]any{"$ref": schemaCuratedURL})
+	}
+
+	for _, d := range c.Dependencies() {
+		v.known[d.Name()] = struct{}{}
+
+		if len(d.Schema) == 0 {
+			continue
+		}
+
+		url := "file:///charts/" + d.Name() + "/values.schema.json"
+
+		if err := addSchema(compiler, url, d.Schema); err != nil {
+			return nil, err
+		}
+
+		subcharts[d.Name()] = map[string]any{"$ref": url}
+	}
+
+	if len(subcharts) > 0 {
+		combined = append(combined, map[string]any{
+			"type":       "object",
+			"properties": subcharts,
+		})
+	}
+
+	doc := map[string]any{
+		"allOf": combined,
+	}
+
+	if len(combined) == 0 {
+		doc = map[string]any{}
+	}
+
+	if err := compiler.AddResource("file:///combined.schema.json", doc); err != nil {
+		return nil, fmt.Errorf("unable to add combined values schema: %w", err)
+	}
+
+	s, err := compiler.Compile("file:///combined.schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile values schema: %w", err)
+	}
+
+	v.schema = s
+
+	return &v, nil
+}
+
+// Validate validates the given values, it returns the top level keys that are unknown to
+// the chart, that are silently ignored when rendering, and an error wrapping
+// ErrInvalidValues if the values do not comply with the schema.
+func (v *ValuesValidator) Validate(values map[string]interface{}) ([]string, error) {
+	unknown := make([]string, 0)
+
+	for _, k := range slices.Sorted(maps.Keys(values)) {
+		if _, ok := v.known[k]; !ok {
+			unknown = append(unknown, k)
+		}
+	}
+
+	// the values are round-tripped through the JSON decoder of the validator, so
+	// they are made of the types it expects
+	data, err := json.Marshal(values)
+	if err != nil {
+		return unknown, fmt.Errorf("unable to encode values: %w", err)
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return unknown, fmt.Errorf("unable to decode values: %w", err)
+	}
+
+	if err := v.schema.Validate(doc); err != nil {
+		return unknown, fmt.Errorf("%w: %s", ErrInvalidValues, err.Error())
+	}
+
+	return unknown, nil
+}
+
+func addSchema(compiler *jsonschema.Compiler, url string, data []byte) error {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("unable to decode schema %s: %w", url, err)
+	}
+
+	if err := compiler.AddResource(url, doc); err != nil {
+		return fmt.Errorf("unable to add schema %s: %w", url, err)
+	}
+
+	return nil
+}
+
+// ValuesSchema returns a JSON Schema describing the values of the given chart, the type and
+// the default of each value are inferred from the values of the chart and of its subcharts.
+// The values.schema.json shipped by the chart and by its subcharts, if any, are combined
+// with the inferred schema.
+func ValuesSchema(c *chart.Chart) (map[string]any, error) {
+	values, err := chartutil.CoalesceValues(c, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute the values of chart %s: %w", c.Name(), err)
+	}
+
+	inferred := inferSchema(map[string]interface{}(values))
+
+	properties, ok := inferred["properties"].(map[string]any)
+	if !ok {
+		properties = make(map[string]any)
+		inferred["properties"] = properties
+	}
+
+	for _, d := range c.Dependencies() {
+		if len(d.Schema) == 0 {
+			continue
+		}
+
+		schema, err := embeddableSchema(d.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the values schema of subchart %s: %w", d.Name(), err)
+		}
+
+		if p, ok := properties[d.Name()]; ok {
+			schema = map[string]any{"allOf": []any{p, schema}}
+		}
+
+		properties[d.Name()] = schema
+	}
+
+	if len(c.Schema) == 0 {
+		return inferred, nil
+	}
+
+	schema, err := embeddableSchema(c.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the values schema of chart %s: %w", c.Name(), err)
+	}
+
+	return map[string]any{"allOf": []any{schema, inferred}}, nil
+}
+
+// embeddableSchema decodes the given schema removing the keywords that identify it as a
+// standalone document, so it can be embedded in another one.
+func embeddableSchema(data []byte) (map[string]any, error) {
+	schema := make(map[string]any)
+
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("unable to decode schema: %w", err)
+	}
+
+	delete(schema, "$schema")
+	delete(schema, "$id")
+
+	return schema, nil
+}
+
+func inferSchema(value interface{}) map[string]any {
+	switch v := value.(type) {
+	case chartutil.Values:
+		return inferSchema(map[string]interface{}(v))
+	case map[string]interface{}:
+		properties := make(map[string]any, len(v))
+
+		for k, pv := range v {
+			properties[k] = inferSchema(pv)
+		}
+
+		return map[string]any{
+			"type":       "object",
+			"properties": properties,
+		}
+	case []interface{}:
+		return map[string]any{"type": "array", "default": v}
+	case string:
+		return map[string]any{"type": "string", "default": v}
+	case bool:
+		return map[string]any{"type": "boolean", "default": v}
+	case int, int32, int64, float32, float64:
+		return map[string]any{"type": "number", "default": v}
+	default:
+		// values without a default, as null ones, accept any type
+		return map[string]any{}
+	}
+}
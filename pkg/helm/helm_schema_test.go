@@ -0,0 +1,153 @@
+package helm_test
+
+import (
+	"testing"
+
+	"helm.sh/helm/v3/pkg/chart"
+
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+// subchartSchema requires the image of the subchart to be fully set, which is only the
+// case once the values are merged with the defaults of the subchart.
+const subchartSchema = `
+{
+  "type": "object",
+  "required": [ "image" ],
+  "properties": {
+    "image": {
+      "type": "object",
+      "required": [ "name", "tag" ],
+      "properties": {
+        "name": { "type": "string" },
+        "tag": { "type": "string" }
+      }
+    }
+  }
+}
+`
+
+func testChart() *chart.Chart {
+	sub := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "injector", Version: "1.0.0", APIVersion: chart.APIVersionV2},
+		Values: map[string]interface{}{
+			"image": map[string]interface{}{
+				"name": "daprd",
+				"tag":  "1.0.0",
+			},
+		},
+		Schema: []byte(subchartSchema),
+	}
+
+	c := &chart.Chart{
+		Metadata: &chart.Metadata{
+			Name:         "dapr",
+			Version:      "1.0.0",
+			APIVersion:   chart.APIVersionV2,
+			Dependencies: []*chart.Dependency{{Name: "injector", Version: "1.0.0"}},
+		},
+		Values: map[string]interface{}{
+			"global": map[string]interface{}{
+				"ha": map[string]interface{}{
+					"enabled": false,
+				},
+			},
+		},
+	}
+
+	c.SetDependencies(sub)
+
+	return c
+}
+
+func TestCoalesceValues(t *testing.T) {
+	g := NewWithT(t)
+
+	values, err := helm.CoalesceValues(testChart(), map[string]interface{}{
+		"injector": map[string]interface{}{
+			"image": map[string]interface{}{
+				"name": "custom",
+			},
+		},
+	})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(values).To(HaveKeyWithValue("injector", HaveKeyWithValue("image", And(
+		HaveKeyWithValue("name", "custom"),
+		HaveKeyWithValue("tag", "1.0.0"),
+	))))
+	g.Expect(values).To(HaveKeyWithValue("global", HaveKeyWithValue("ha", HaveKeyWithValue("enabled", false))))
+}
+
+func TestValuesValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]interface{}
+		unknown []string
+		invalid bool
+	}{
+		{
+			name:    "defaults",
+			values:  map[string]interface{}{},
+			unknown: []string{},
+		},
+		{
+			name: "partial subchart values",
+			values: map[string]interface{}{
+				"injector": map[string]interface{}{
+					"image": map[string]interface{}{"name": "custom"},
+				},
+			},
+			unknown: []string{},
+		},
+		{
+			name: "invalid subchart values",
+			values: map[string]interface{}{
+				"injector": map[string]interface{}{
+					"image": map[string]interface{}{"tag": 1},
+				},
+			},
+			invalid: true,
+		},
+		{
+			name: "invalid additional values",
+			values: map[string]interface{}{
+				"global": map[string]interface{}{"ha": "yes"},
+			},
+			invalid: true,
+		},
+		{
+			name: "unknown keys",
+			values: map[string]interface{}{
+				"injektor": map[string]interface{}{},
+			},
+			unknown: []string{"injektor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := testChart()
+
+			v, err := helm.NewValuesValidator(c, []byte(`{"properties":{"global":{"properties":{"ha":{"type":"object"}}}}}`))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			values, err := helm.CoalesceValues(c, tt.values)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			unknown, err := v.Validate(values)
+			if tt.invalid {
+				g.Expect(err).To(MatchError(helm.ErrInvalidValues))
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(unknown).To(Equal(tt.unknown))
+		})
+	}
+}
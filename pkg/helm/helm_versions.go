@@ -0,0 +1,239 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+const (
+	DefaultResolveInterval = time.Hour
+)
+
+var (
+	ErrNoMatchingVersion  = errors.New("no matching version")
+	ErrChartNotFound      = errors.New("chart not found")
+	ErrUnsupportedUpgrade = errors.New("unsupported upgrade")
+)
+
+type RepositoryOptions struct {
+	Username string
+	Password string
+}
+
+// IsVersionConstraint returns true if the given version is not an exact version but
+// a semver constraint such as ~1.16.0 or ">=1.15 <1.17".
+func IsVersionConstraint(version string) bool {
+	if version == "" {
+		return false
+	}
+
+	if _, err := semver.NewVersion(version); err == nil {
+		return false
+	}
+
+	_, err := semver.NewConstraint(version)
+
+	return err == nil
+}
+
+// SatisfiesConstraint returns true if the given version satisfies the given constraint.
+func SatisfiesConstraint(version string, constraint string) bool {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false
+	}
+
+	return c.Check(v)
+}
+
+// ResolveVersion returns the highest of the given versions satisfying the given constraint.
+func ResolveVersion(versions []*semver.Version, constraint string) (*semver.Version, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint %s: %w", constraint, err)
+	}
+
+	var answer *semver.Version
+
+	for _, v := range versions {
+		if !c.Check(v) {
+			continue
+		}
+
+		if answer == nil || v.GreaterThan(answer) {
+			answer = v
+		}
+	}
+
+	if answer == nil {
+		return nil, fmt.Errorf("%w for constraint %s", ErrNoMatchingVersion, constraint)
+	}
+
+	return answer, nil
+}
+
+// IsRemoteChart returns true if the chart is published in an HTTP or OCI repository.
+func IsRemoteChart(repoURL string, name string) bool {
+	return repoURL != "" || registry.IsOCI(name)
+}
+
+// ChartVersions returns the semver compliant versions of a chart published in an HTTP or OCI
+// repository, sorted in descending order.
+func ChartVersions(repoURL string, name string, opts RepositoryOptions) ([]*semver.Version, error) {
+	var tags []string
+	var err error
+
+	switch {
+	case registry.IsOCI(name):
+		tags, err = ociTags(name, opts)
+	case registry.IsOCI(repoURL):
+		tags, err = ociTags(strings.TrimSuffix(repoURL, "/")+"/"+name, opts)
+	default:
+		tags, err = indexVersions(repoURL, name, opts)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*semver.Version, 0, len(tags))
+
+	for _, t := range tags {
+		v, err := semver.NewVersion(t)
+		if err != nil {
+			continue
+		}
+
+		versions = append(versions, v)
+	}
+
+	sort.Sort(sort.Reverse(semver.Collection(versions)))
+
+	return versions, nil
+}
+
+func ociTags(ref string, opts RepositoryOptions) ([]string, error) {
+	rco := make([]registry.ClientOption, 0)
+
+	if opts.Username != "" || opts.Password != "" {
+		rco = append(rco, registry.ClientOptBasicAuth(opts.Username, opts.Password))
+	}
+
+	rc, err := registry.NewClient(rco...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create registry client: %w", err)
+	}
+
+	tags, err := rc.Tags(strings.TrimPrefix(ref, registry.OCIScheme+"://"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list tags of %s: %w", ref, err)
+	}
+
+	return tags, nil
+}
+
+func indexVersions(repoURL string, name string, opts RepositoryOptions) ([]string, error) {
+	settings := cli.New()
+
+	r, err := repo.NewChartRepository(
+		&repo.Entry{
+			// the name is only used to compute the name of the cached index file
+			Name:     fmt.Sprintf("%x", sha256.Sum256([]byte(repoURL))),
+			URL:      repoURL,
+			Username: opts.Username,
+			Password: opts.Password,
+		},
+		getter.All(settings),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create chart repository %s: %w", repoURL, err)
+	}
+
+	r.CachePath = settings.RepositoryCache
+
+	path, err := r.DownloadIndexFile()
+	if err != nil {
+		return nil, fmt.Errorf("unable to download index of chart repository %s: %w", repoURL, err)
+	}
+
+	index, err := repo.LoadIndexFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load index of chart repository %s: %w", repoURL, err)
+	}
+
+	entries, ok := index.Entries[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s in repository %s", ErrChartNotFound, name, repoURL)
+	}
+
+	answer := make([]string, 0, len(entries))
+
+	for _, e := range entries {
+		answer = append(answer, e.Version)
+	}
+
+	return answer, nil
+}
+
+// NewerVersions returns the stable versions greater than the given one, prereleases are
+// only included if the given version is a prerelease too.
+func NewerVersions(versions []*semver.Version, current string) ([]*semver.Version, error) {
+	cv, err := semver.NewVersion(current)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %s: %w", current, err)
+	}
+
+	answer := make([]*semver.Version, 0)
+
+	for _, v := range versions {
+		if v.Prerelease() != "" && cv.Prerelease() == "" {
+			continue
+		}
+
+		if v.GreaterThan(cv) {
+			answer = append(answer, v)
+		}
+	}
+
+	return answer, nil
+}
+
+// ValidateUpgrade checks if the transition between the given chart versions is supported by
+// Dapr, which does not support downgrades nor skipping minor versions.
+func ValidateUpgrade(from string, to string) error {
+	fv, err := semver.NewVersion(from)
+	if err != nil {
+		return fmt.Errorf("invalid version %s: %w", from, err)
+	}
+
+	tv, err := semver.NewVersion(to)
+	if err != nil {
+		return fmt.Errorf("invalid version %s: %w", to, err)
+	}
+
+	switch {
+	case tv.LessThan(fv):
+		return fmt.Errorf("%w: downgrade from %s to %s", ErrUnsupportedUpgrade, from, to)
+	case tv.Major() != fv.Major():
+		return fmt.Errorf("%w: major version change from %s to %s", ErrUnsupportedUpgrade, from, to)
+	case tv.Minor() > fv.Minor()+1:
+		return fmt.Errorf("%w: skipping minor versions from %s to %s", ErrUnsupportedUpgrade, from, to)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package helm_test
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/dapr/kubernetes-operator/pkg/helm"
+
+	. "github.com/onsi/gomega"
+)
+
+func versions(items ...string) []*semver.Version {
+	answer := make([]*semver.Version, 0, len(items))
+
+	for _, i := range items {
+		answer = append(answer, semver.MustParse(i))
+	}
+
+	return answer
+}
+
+func TestIsVersionConstraint(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected bool
+	}{
+		{name: "empty", version: "", expected: false},
+		{name: "exact", version: "1.16.1", expected: false},
+		{name: "exact with v prefix", version: "v1.16.1", expected: false},
+		{name: "tilde", version: "~1.16.0", expected: true},
+		{name: "caret", version: "^1.15", expected: true},
+		{name: "range", version: ">=1.15 <1.17", expected: true},
+		{name: "invalid", version: "latest", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(helm.IsVersionConstraint(tt.version)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	available := versions("1.14.4", "1.15.0", "1.15.3", "1.16.0", "1.16.1", "1.17.0-rc.1")
+
+	tests := []struct {
+		name       string
+		constraint string
+		expected   string
+		err        error
+	}{
+		{name: "tilde", constraint: "~1.15.0", expected: "1.15.3"},
+		{name: "caret", constraint: "^1.15", expected: "1.16.1"},
+		{name: "range", constraint: ">=1.14 <1.16", expected: "1.15.3"},
+		{name: "prereleases are excluded", constraint: ">=1.16", expected: "1.16.1"},
+		{name: "no match", constraint: "~1.13.0", err: helm.ErrNoMatchingVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v, err := helm.ResolveVersion(available, tt.constraint)
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(v.String()).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestResolveVersionInvalidConstraint(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := helm.ResolveVersion(versions("1.16.1"), "not a constraint")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewerVersions(t *testing.T) {
+	available := versions("1.14.4", "1.15.0", "1.15.3", "1.16.0", "1.16.1", "1.17.0-rc.1")
+
+	tests := []struct {
+		name     string
+		current  string
+		expected []string
+	}{
+		{name: "stable", current: "1.15.0", expected: []string{"1.15.3", "1.16.0", "1.16.1"}},
+		{name: "latest", current: "1.16.1", expected: []string{}},
+		{name: "prerelease", current: "1.16.1-rc.1", expected: []string{"1.16.1", "1.17.0-rc.1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			newer, err := helm.NewerVersions(available, tt.current)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			names := make([]string, 0, len(newer))
+
+			for _, v := range newer {
+				names = append(names, v.String())
+			}
+
+			g.Expect(names).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestNewerVersionsInvalidVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := helm.NewerVersions(versions("1.16.1"), "latest")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestValidateUpgrade(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		err  error
+	}{
+		{name: "same version", from: "1.16.1", to: "1.16.1"},
+		{name: "patch", from: "1.16.0", to: "1.16.1"},
+		{name: "next minor", from: "1.15.3", to: "1.16.1"},
+		{name: "skip minor", from: "1.14.4", to: "1.16.1", err: helm.ErrUnsupportedUpgrade},
+		{name: "downgrade", from: "1.16.1", to: "1.16.0", err: helm.ErrUnsupportedUpgrade},
+		{name: "major", from: "1.16.1", to: "2.0.0", err: helm.ErrUnsupportedUpgrade},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := helm.ValidateUpgrade(tt.from, tt.to)
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
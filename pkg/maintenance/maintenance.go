@@ -0,0 +1,93 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	// embed the IANA time zone database as the operator image may not provide one.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+// MinDuration is the minimum duration of a window, as the schedule has a granularity of one minute.
+const MinDuration = time.Minute
+
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// Evaluate determines if any of the given windows is open at the given time, if none is,
+// the time at which the next window opens is returned.
+func Evaluate(windows []daprApi.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	var next time.Time
+
+	for i := range windows {
+		open, start, err := evaluate(windows[i], now)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid maintenance window %d: %w", i, err)
+		}
+
+		if open {
+			return true, time.Time{}, nil
+		}
+
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+
+	return false, next, nil
+}
+
+// Validate checks that the schedule and the time zone of the given window can be parsed, and
+// that the window stays open for at least MinDuration.
+func Validate(w daprApi.MaintenanceWindow) error {
+	if w.Duration.Duration < MinDuration {
+		return fmt.Errorf("%w: %s, must be at least %s", ErrInvalidDuration, w.Duration.Duration, MinDuration)
+	}
+
+	_, _, err := parse(w)
+
+	return err
+}
+
+func parse(w daprApi.MaintenanceWindow) (cron.Schedule, *time.Location, error) {
+	loc := time.UTC
+
+	if w.TimeZone != "" {
+		l, err := time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to load time zone %s: %w", w.TimeZone, err)
+		}
+
+		loc = l
+	}
+
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse schedule %s: %w", w.Schedule, err)
+	}
+
+	return schedule, loc, nil
+}
+
+func evaluate(w daprApi.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	schedule, loc, err := parse(w)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	now = now.In(loc)
+
+	// the window is open if it has been activated within the last duration, since Next
+	// returns the first activation strictly after the given time, the result is either
+	// in the past (window open) or the next activation (window closed)
+	start := schedule.Next(now.Add(-w.Duration.Duration))
+	if !start.After(now) {
+		return true, start, nil
+	}
+
+	return false, start, nil
+}
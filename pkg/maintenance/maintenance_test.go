@@ -0,0 +1,143 @@
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/kubernetes-operator/pkg/maintenance"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+
+	. "github.com/onsi/gomega"
+)
+
+func window(schedule string, duration time.Duration, tz string) daprApi.MaintenanceWindow {
+	return daprApi.MaintenanceWindow{
+		Schedule: schedule,
+		Duration: metav1.Duration{Duration: duration},
+		TimeZone: tz,
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	// a Monday
+	now := time.Date(2025, time.March, 3, 2, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		windows []daprApi.MaintenanceWindow
+		open    bool
+		next    time.Time
+	}{
+		{
+			name:    "open",
+			windows: []daprApi.MaintenanceWindow{window("0 2 * * *", time.Hour, "")},
+			open:    true,
+		},
+		{
+			name:    "closed",
+			windows: []daprApi.MaintenanceWindow{window("0 4 * * *", time.Hour, "")},
+			open:    false,
+			next:    time.Date(2025, time.March, 3, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "closed at the end of the window",
+			windows: []daprApi.MaintenanceWindow{window("0 2 * * *", 30*time.Minute, "")},
+			open:    false,
+			next:    time.Date(2025, time.March, 4, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "time zone",
+			windows: []daprApi.MaintenanceWindow{window("0 3 * * *", time.Hour, "Europe/Rome")},
+			open:    true,
+		},
+		{
+			name: "earliest of the closed windows",
+			windows: []daprApi.MaintenanceWindow{
+				window("0 6 * * *", time.Hour, ""),
+				window("0 4 * * *", time.Hour, ""),
+			},
+			open: false,
+			next: time.Date(2025, time.March, 3, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "any of the windows is open",
+			windows: []daprApi.MaintenanceWindow{
+				window("0 6 * * *", time.Hour, ""),
+				window("0 2 * * MON", time.Hour, ""),
+			},
+			open: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			open, next, err := maintenance.Evaluate(tt.windows, now)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(open).To(Equal(tt.open))
+
+			if !tt.open {
+				g.Expect(next.Equal(tt.next)).To(BeTrue(), "expected %s, got %s", tt.next, next)
+			}
+		})
+	}
+}
+
+func TestEvaluateInvalid(t *testing.T) {
+	now := time.Date(2025, time.March, 3, 2, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		window daprApi.MaintenanceWindow
+	}{
+		{name: "schedule", window: window("not a schedule", time.Hour, "")},
+		{name: "time zone", window: window("0 2 * * *", time.Hour, "Nowhere/Unknown")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, _, err := maintenance.Evaluate([]daprApi.MaintenanceWindow{tt.window}, now)
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		window daprApi.MaintenanceWindow
+		valid  bool
+		err    error
+	}{
+		{name: "valid", window: window("0 2 * * *", time.Hour, "Europe/Rome"), valid: true},
+		{name: "minimum duration", window: window("0 2 * * *", maintenance.MinDuration, ""), valid: true},
+		{name: "zero duration", window: window("0 2 * * *", 0, ""), err: maintenance.ErrInvalidDuration},
+		{name: "negative duration", window: window("0 2 * * *", -time.Hour, ""), err: maintenance.ErrInvalidDuration},
+		{name: "short duration", window: window("0 2 * * *", 30*time.Second, ""), err: maintenance.ErrInvalidDuration},
+		{name: "schedule", window: window("not a schedule", time.Hour, "")},
+		{name: "time zone", window: window("0 2 * * *", time.Hour, "Nowhere/Unknown")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := maintenance.Validate(tt.window)
+
+			switch {
+			case tt.valid:
+				g.Expect(err).ToNot(HaveOccurred())
+			case tt.err != nil:
+				g.Expect(err).To(MatchError(tt.err))
+			default:
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package podsecurity
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/pod-security-admission/api"
+	"k8s.io/pod-security-admission/policy"
+)
+
+const (
+	CapabilityAll            = "ALL"
+	CapabilityNetBindService = "NET_BIND_SERVICE"
+	SeccompRuntimeDefault    = "RuntimeDefault"
+	SeccompUnconfined        = "Unconfined"
+)
+
+// baselineCapabilities are the capabilities that can be added at the baseline level.
+var baselineCapabilities = []string{
+	"AUDIT_WRITE",
+	"CHOWN",
+	"DAC_OVERRIDE",
+	"FOWNER",
+	"FSETID",
+	"KILL",
+	"MKNOD",
+	CapabilityNetBindService,
+	"SETFCAP",
+	"SETGID",
+	"SETPCAP",
+	"SETUID",
+	"SYS_CHROOT",
+}
+
+// TemplatePath returns the path of the pod template of the given object, if the kind of the
+// object embeds one.
+func TemplatePath(obj *unstructured.Unstructured) ([]string, bool) {
+	gvk := obj.GroupVersionKind()
+
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Pod":
+		return []string{}, true
+	case gvk.Group == "apps" && (gvk.Kind == "Deployment" || gvk.Kind == "StatefulSet" || gvk.Kind == "DaemonSet" || gvk.Kind == "ReplicaSet"):
+		return []string{"spec", "template"}, true
+	case gvk.Group == "batch" && gvk.Kind == "Job":
+		return []string{"spec", "template"}, true
+	case gvk.Group == "batch" && gvk.Kind == "CronJob":
+		return []string{"spec", "jobTemplate", "spec", "template"}, true
+	default:
+		return nil, false
+	}
+}
+
+// Harden adjusts the pod template of the given object, if any, so it complies with the given
+// Pod Security Standards level. At the baseline level:
+//
+// - the Unconfined seccomp profile is replaced by RuntimeDefault
+// - the capabilities that are added are limited to the ones allowed by the baseline level
+//
+// At the restricted level, in addition:
+//
+// - the RuntimeDefault seccomp profile is set if none is set
+// - privilege escalation is disallowed and containers must run as non-root users
+// - all the capabilities are dropped, the only capability that can be added is NET_BIND_SERVICE
+// - the root filesystem is made read-only unless explicitly set otherwise
+//
+// Settings that cannot be adjusted without changing the semantic of the workload, such as
+// privileged containers or host namespaces, are left untouched and reported by Evaluate.
+func Harden(obj *unstructured.Unstructured, level api.Level) error {
+	path, ok := TemplatePath(obj)
+	if !ok {
+		return nil
+	}
+
+	specPath := slices.Concat(path, []string{"spec"})
+
+	psc, _, err := unstructured.NestedMap(obj.Object, slices.Concat(specPath, []string{"securityContext"})...)
+	if err != nil {
+		//nolint:wrapcheck
+		return err
+	}
+
+	t, _, _ := unstructured.NestedString(psc, "seccompProfile", "type")
+	if t == SeccompUnconfined || (t == "" && level == api.LevelRestricted) {
+		if psc == nil {
+			psc = make(map[string]interface{})
+		}
+
+		psc["seccompProfile"] = map[string]interface{}{"type": SeccompRuntimeDefault}
+
+		if err := unstructured.SetNestedMap(obj.Object, psc, slices.Concat(specPath, []string{"securityContext"})...); err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+	}
+
+	for _, field := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		containers, ok, err := unstructured.NestedSlice(obj.Object, slices.Concat(specPath, []string{field})...)
+		if err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		for i := range containers {
+			container, ok := containers[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if sc := hardenContainer(container["securityContext"], level); len(sc) > 0 {
+				container["securityContext"] = sc
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, containers, slices.Concat(specPath, []string{field})...); err != nil {
+			//nolint:wrapcheck
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hardenContainer(in interface{}, level api.Level) map[string]interface{} {
+	sc, ok := in.(map[string]interface{})
+	if !ok || sc == nil {
+		sc = make(map[string]interface{})
+	}
+
+	if t, _, _ := unstructured.NestedString(sc, "seccompProfile", "type"); t == SeccompUnconfined {
+		sc["seccompProfile"] = map[string]interface{}{"type": SeccompRuntimeDefault}
+	}
+
+	capabilities, ok := sc["capabilities"].(map[string]interface{})
+	if !ok || capabilities == nil {
+		capabilities = make(map[string]interface{})
+	}
+
+	allowed := baselineCapabilities
+
+	if level == api.LevelRestricted {
+		if privileged, _, _ := unstructured.NestedBool(sc, "privileged"); !privileged {
+			sc["allowPrivilegeEscalation"] = false
+		}
+
+		sc["runAsNonRoot"] = true
+
+		if _, ok := sc["readOnlyRootFilesystem"]; !ok {
+			sc["readOnlyRootFilesystem"] = true
+		}
+
+		capabilities["drop"] = []interface{}{CapabilityAll}
+
+		allowed = []string{CapabilityNetBindService}
+	}
+
+	if add, ok := capabilities["add"].([]interface{}); ok {
+		add = slices.DeleteFunc(add, func(c interface{}) bool {
+			name, ok := c.(string)
+
+			return !ok || !slices.Contains(allowed, name)
+		})
+
+		if len(add) == 0 {
+			delete(capabilities, "add")
+		} else {
+			capabilities["add"] = add
+		}
+	}
+
+	if len(capabilities) > 0 {
+		sc["capabilities"] = capabilities
+	}
+
+	return sc
+}
+
+// Evaluator evaluates pod templates against a Pod Security Standards level.
+type Evaluator struct {
+	evaluator policy.Evaluator
+	level     api.LevelVersion
+}
+
+func NewEvaluator(level api.Level) (*Evaluator, error) {
+	e, err := policy.NewEvaluator(policy.DefaultChecks())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create pod security evaluator: %w", err)
+	}
+
+	return &Evaluator{
+		evaluator: e,
+		level: api.LevelVersion{
+			Level:   level,
+			Version: api.LatestVersion(),
+		},
+	}, nil
+}
+
+// Evaluate evaluates the pod template of the given object, if any, and returns the reasons
+// why it does not comply with the level of the Evaluator.
+func (e *Evaluator) Evaluate(obj *unstructured.Unstructured) ([]string, error) {
+	path, ok := TemplatePath(obj)
+	if !ok {
+		return nil, nil
+	}
+
+	template, _, err := unstructured.NestedMap(obj.Object, path...)
+	if err != nil {
+		//nolint:wrapcheck
+		return nil, err
+	}
+
+	pod := corev1.Pod{}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(template, &pod); err != nil {
+		return nil, fmt.Errorf("unable to decode pod template: %w", err)
+	}
+
+	violations := make([]string, 0)
+
+	for _, r := range e.evaluator.EvaluatePod(e.level, &pod.ObjectMeta, &pod.Spec) {
+		if r.Allowed {
+			continue
+		}
+
+		if r.ForbiddenDetail != "" {
+			violations = append(violations, r.ForbiddenReason+" ("+r.ForbiddenDetail+")")
+		} else {
+			violations = append(violations, r.ForbiddenReason)
+		}
+	}
+
+	return violations, nil
+}
+
+// Summary formats the given violations, indexed by object reference, in a stable way.
+func Summary(violations map[string][]string) string {
+	items := make([]string, 0, len(violations))
+
+	for _, ref := range slices.Sorted(maps.Keys(violations)) {
+		items = append(items, ref+": "+strings.Join(violations[ref], ", "))
+	}
+
+	return strings.Join(items, "; ")
+}
@@ -0,0 +1,294 @@
+package podsecurity_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/pod-security-admission/api"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/kubernetes-operator/pkg/podsecurity"
+
+	. "github.com/onsi/gomega"
+)
+
+func deployment(t *testing.T, template string) *unstructured.Unstructured {
+	t.Helper()
+
+	obj := unstructured.Unstructured{}
+
+	data := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-operator
+spec:
+  template:
+` + template
+
+	if err := yaml.Unmarshal([]byte(data), &obj.Object); err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+
+	return &obj
+}
+
+func containerSecurityContext(t *testing.T, obj *unstructured.Unstructured) map[string]interface{} {
+	t.Helper()
+
+	containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	if err != nil || len(containers) == 0 {
+		t.Fatalf("no containers: %v", err)
+	}
+
+	sc, _, err := unstructured.NestedMap(containers[0].(map[string]interface{}), "securityContext")
+	if err != nil {
+		t.Fatalf("invalid security context: %v", err)
+	}
+
+	return sc
+}
+
+func TestHarden(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    api.Level
+		template string
+		pod      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:  "restricted",
+			level: api.LevelRestricted,
+			template: `
+    spec:
+      containers:
+      - name: operator
+`,
+			pod: map[string]interface{}{
+				"seccompProfile": map[string]interface{}{"type": "RuntimeDefault"},
+			},
+			expected: map[string]interface{}{
+				"allowPrivilegeEscalation": false,
+				"runAsNonRoot":             true,
+				"readOnlyRootFilesystem":   true,
+				"capabilities": map[string]interface{}{
+					"drop": []interface{}{"ALL"},
+				},
+			},
+		},
+		{
+			name:  "restricted keeps explicit settings",
+			level: api.LevelRestricted,
+			template: `
+    spec:
+      securityContext:
+        seccompProfile:
+          type: Localhost
+          localhostProfile: dapr.json
+      containers:
+      - name: operator
+        securityContext:
+          readOnlyRootFilesystem: false
+          capabilities:
+            add: [ "NET_BIND_SERVICE", "NET_ADMIN" ]
+`,
+			pod: map[string]interface{}{
+				"seccompProfile": map[string]interface{}{"type": "Localhost", "localhostProfile": "dapr.json"},
+			},
+			expected: map[string]interface{}{
+				"allowPrivilegeEscalation": false,
+				"runAsNonRoot":             true,
+				"readOnlyRootFilesystem":   false,
+				"capabilities": map[string]interface{}{
+					"add":  []interface{}{"NET_BIND_SERVICE"},
+					"drop": []interface{}{"ALL"},
+				},
+			},
+		},
+		{
+			name:  "baseline leaves compliant templates untouched",
+			level: api.LevelBaseline,
+			template: `
+    spec:
+      containers:
+      - name: operator
+`,
+			pod:      nil,
+			expected: nil,
+		},
+		{
+			name:  "baseline",
+			level: api.LevelBaseline,
+			template: `
+    spec:
+      securityContext:
+        seccompProfile:
+          type: Unconfined
+      containers:
+      - name: operator
+        securityContext:
+          seccompProfile:
+            type: Unconfined
+          capabilities:
+            add: [ "CHOWN", "SYS_ADMIN" ]
+`,
+			pod: map[string]interface{}{
+				"seccompProfile": map[string]interface{}{"type": "RuntimeDefault"},
+			},
+			expected: map[string]interface{}{
+				"seccompProfile": map[string]interface{}{"type": "RuntimeDefault"},
+				"capabilities": map[string]interface{}{
+					"add": []interface{}{"CHOWN"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := deployment(t, tt.template)
+			g.Expect(podsecurity.Harden(obj, tt.level)).To(Succeed())
+
+			psc, _, err := unstructured.NestedMap(obj.Object, "spec", "template", "spec", "securityContext")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(psc).To(Equal(tt.pod))
+
+			g.Expect(containerSecurityContext(t, obj)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestHardenIgnoresOtherKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("dapr-config")
+
+	expected := obj.DeepCopy()
+
+	g.Expect(podsecurity.Harden(&obj, api.LevelRestricted)).To(Succeed())
+	g.Expect(obj.Object).To(Equal(expected.Object))
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name      string
+		level     api.Level
+		harden    bool
+		template  string
+		compliant bool
+	}{
+		{
+			name:   "hardened at restricted",
+			level:  api.LevelRestricted,
+			harden: true,
+			template: `
+    spec:
+      containers:
+      - name: operator
+`,
+			compliant: true,
+		},
+		{
+			name:  "not hardened at restricted",
+			level: api.LevelRestricted,
+			template: `
+    spec:
+      containers:
+      - name: operator
+`,
+			compliant: false,
+		},
+		{
+			name:  "baseline",
+			level: api.LevelBaseline,
+			template: `
+    spec:
+      containers:
+      - name: operator
+`,
+			compliant: true,
+		},
+		{
+			name:   "host network",
+			level:  api.LevelBaseline,
+			harden: true,
+			template: `
+    spec:
+      hostNetwork: true
+      containers:
+      - name: operator
+`,
+			compliant: false,
+		},
+		{
+			name:   "privileged",
+			level:  api.LevelBaseline,
+			harden: true,
+			template: `
+    spec:
+      containers:
+      - name: operator
+        securityContext:
+          privileged: true
+`,
+			compliant: false,
+		},
+		{
+			name:   "unconfined AppArmor annotation",
+			level:  api.LevelBaseline,
+			harden: true,
+			template: `
+    metadata:
+      annotations:
+        container.apparmor.security.beta.kubernetes.io/operator: unconfined
+    spec:
+      containers:
+      - name: operator
+`,
+			compliant: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := deployment(t, tt.template)
+
+			if tt.harden {
+				g.Expect(podsecurity.Harden(obj, tt.level)).To(Succeed())
+			}
+
+			e, err := podsecurity.NewEvaluator(tt.level)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			violations, err := e.Evaluate(obj)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			if tt.compliant {
+				g.Expect(violations).To(BeEmpty())
+			} else {
+				g.Expect(violations).ToNot(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	g := NewWithT(t)
+
+	s := podsecurity.Summary(map[string][]string{
+		"apps/v1/Deployment/dapr-system/dapr-sentry":   {"host namespaces (hostNetwork=true)"},
+		"apps/v1/Deployment/dapr-system/dapr-operator": {"privileged", "hostPath volumes"},
+	})
+
+	g.Expect(s).To(Equal(
+		"apps/v1/Deployment/dapr-system/dapr-operator: privileged, hostPath volumes; " +
+			"apps/v1/Deployment/dapr-system/dapr-sentry: host namespaces (hostNetwork=true)"))
+}
@@ -2,6 +2,9 @@ package resources
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -117,6 +120,24 @@ func ToUnstructured(s *runtime.Scheme, obj runtime.Object) (*unstructured.Unstru
 	}
 }
 
+// Digest computes a stable digest of the given objects, which is sensitive to the order of
+// the objects.
+func Digest(objects ...unstructured.Unstructured) (string, error) {
+	h := sha256.New()
+
+	for i := range objects {
+		// maps are marshalled with sorted keys, hence the encoding is stable
+		data, err := json.Marshal(objects[i].Object)
+		if err != nil {
+			return "", fmt.Errorf("unable to marshal resource: %w", err)
+		}
+
+		h.Write(data)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func Decode(decoder runtime.Decoder, content []byte) ([]unstructured.Unstructured, error) {
 	results := make([]unstructured.Unstructured, 0)
 
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// StrategicMergePatch applies the given strategic merge patch, in JSON form, to the given
+// object. Objects whose type is not known to the scheme, such as custom resources, do not
+// carry the patch strategies and are patched with a JSON merge patch instead.
+func StrategicMergePatch(s *runtime.Scheme, obj *unstructured.Unstructured, patch []byte) error {
+	original, err := obj.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("unable to marshal object: %w", err)
+	}
+
+	var patched []byte
+
+	typed, err := s.New(obj.GroupVersionKind())
+
+	switch {
+	case runtime.IsNotRegisteredError(err):
+		patched, err = jsonpatch.MergePatch(original, patch)
+	case err != nil:
+		return fmt.Errorf("unable to determine the type of the object: %w", err)
+	default:
+		patched, err = strategicpatch.StrategicMergePatch(original, patch, typed)
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to apply patch: %w", err)
+	}
+
+	if err := obj.UnmarshalJSON(patched); err != nil {
+		return fmt.Errorf("unable to unmarshal patched object: %w", err)
+	}
+
+	return nil
+}
+
+// JSONPatch applies the given RFC 6902 JSON patch, in JSON form, to the given object.
+func JSONPatch(obj *unstructured.Unstructured, patch []byte) error {
+	p, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return fmt.Errorf("unable to decode patch: %w", err)
+	}
+
+	original, err := obj.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("unable to marshal object: %w", err)
+	}
+
+	patched, err := p.Apply(original)
+	if err != nil {
+		return fmt.Errorf("unable to apply patch: %w", err)
+	}
+
+	if err := obj.UnmarshalJSON(patched); err != nil {
+		return fmt.Errorf("unable to unmarshal patched object: %w", err)
+	}
+
+	return nil
+}
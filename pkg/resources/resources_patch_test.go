@@ -0,0 +1,187 @@
+package resources_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/kubernetes-operator/pkg/resources"
+	"github.com/onsi/gomega/types"
+
+	. "github.com/onsi/gomega"
+)
+
+const deployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: dapr-operator
+  labels:
+    app: dapr-operator
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: dapr-operator
+        image: daprio/operator:1.16.1
+        env:
+        - name: LOG_LEVEL
+          value: info
+      - name: sidecar
+        image: busybox
+`
+
+const customResource = `
+apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: daprsystem
+spec:
+  mtls:
+    enabled: true
+  features:
+  - name: one
+`
+
+func object(t *testing.T, data string) *unstructured.Unstructured {
+	t.Helper()
+
+	obj := unstructured.Unstructured{}
+
+	if err := yaml.Unmarshal([]byte(data), &obj.Object); err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+
+	return &obj
+}
+
+func TestStrategicMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		object   string
+		patch    string
+		path     []string
+		expected types.GomegaMatcher
+	}{
+		{
+			name:     "sets fields",
+			object:   deployment,
+			patch:    `{"spec":{"replicas":3}}`,
+			path:     []string{"spec", "replicas"},
+			expected: BeEquivalentTo(3),
+		},
+		{
+			name:     "removes fields",
+			object:   deployment,
+			patch:    `{"metadata":{"labels":{"app":null}}}`,
+			path:     []string{"metadata", "labels"},
+			expected: BeEmpty(),
+		},
+		{
+			name:     "replaces lists of unknown types",
+			object:   customResource,
+			patch:    `{"spec":{"features":[{"name":"two"}]}}`,
+			path:     []string{"spec", "features"},
+			expected: Equal([]interface{}{map[string]interface{}{"name": "two"}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := object(t, tt.object)
+			g.Expect(resources.StrategicMergePatch(scheme.Scheme, obj, []byte(tt.patch))).To(Succeed())
+
+			v, _, err := unstructured.NestedFieldCopy(obj.Object, tt.path...)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(v).To(tt.expected)
+		})
+	}
+}
+
+func TestStrategicMergePatchKeepsOtherContainers(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := object(t, deployment)
+
+	patch := `{"spec":{"template":{"spec":{"containers":[{"name":"dapr-operator","image":"daprio/operator:dev"}]}}}}`
+	g.Expect(resources.StrategicMergePatch(scheme.Scheme, obj, []byte(patch))).To(Succeed())
+
+	containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(containers).To(ConsistOf(
+		And(HaveKeyWithValue("name", "dapr-operator"), HaveKeyWithValue("image", "daprio/operator:dev"), HaveKey("env")),
+		And(HaveKeyWithValue("name", "sidecar"), HaveKeyWithValue("image", "busybox")),
+	))
+}
+
+func TestStrategicMergePatchInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := object(t, deployment)
+	g.Expect(resources.StrategicMergePatch(scheme.Scheme, obj, []byte(`{"spec":`))).ToNot(Succeed())
+}
+
+func TestJSONPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    string
+		path     []string
+		expected types.GomegaMatcher
+		err      bool
+	}{
+		{
+			name:     "add",
+			patch:    `[{"op":"add","path":"/spec/template/spec/containers/0/env/-","value":{"name":"DEBUG","value":"true"}}]`,
+			path:     []string{"spec", "template", "spec", "containers"},
+			expected: ContainElement(HaveKeyWithValue("env", HaveLen(2))),
+		},
+		{
+			name:     "replace",
+			patch:    `[{"op":"replace","path":"/spec/replicas","value":2}]`,
+			path:     []string{"spec", "replicas"},
+			expected: BeEquivalentTo(2),
+		},
+		{
+			name:     "remove",
+			patch:    `[{"op":"remove","path":"/spec/template/spec/containers/1"}]`,
+			path:     []string{"spec", "template", "spec", "containers"},
+			expected: HaveLen(1),
+		},
+		{
+			name:  "missing path",
+			patch: `[{"op":"replace","path":"/spec/missing/field","value":1}]`,
+			err:   true,
+		},
+		{
+			name:  "invalid document",
+			patch: `{"op":"replace"}`,
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := object(t, deployment)
+
+			err := resources.JSONPatch(obj, []byte(tt.patch))
+			if tt.err {
+				g.Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+
+			v, _, err := unstructured.NestedFieldCopy(obj.Object, tt.path...)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(v).To(tt.expected)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package sizing
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+)
+
+//go:embed profiles/*.yaml
+var profiles embed.FS
+
+var ErrUnknownProfile = errors.New("unknown sizing profile")
+
+// Definition returns the definition of the given sizing profile, as chart values in YAML.
+func Definition(p daprApi.SizingProfile) ([]byte, error) {
+	data, err := profiles.ReadFile("profiles/" + string(p) + ".yaml")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, p)
+	}
+
+	return data, nil
+}
+
+// Values returns the chart values of the given sizing profile, the custom profile, as well as
+// no profile, has no values as the sizing is left to the chart values.
+func Values(p daprApi.SizingProfile) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	if p == "" || p == daprApi.SizingProfileCustom {
+		return values, nil
+	}
+
+	data, err := Definition(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("unable to decode sizing profile %s: %w", p, err)
+	}
+
+	return values, nil
+}
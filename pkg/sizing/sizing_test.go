@@ -0,0 +1,84 @@
+package sizing_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/sizing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  daprApi.SizingProfile
+		replicas float64
+		ha       bool
+		storage  string
+	}{
+		{name: "dev", profile: daprApi.SizingProfileDev, replicas: 1, ha: false, storage: "1Gi"},
+		{name: "small", profile: daprApi.SizingProfileSmall, replicas: 1, ha: false, storage: "2Gi"},
+		{name: "medium", profile: daprApi.SizingProfileMedium, replicas: 2, ha: true, storage: "8Gi"},
+		{name: "large", profile: daprApi.SizingProfileLarge, replicas: 3, ha: true, storage: "16Gi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			values, err := sizing.Values(tt.profile)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for _, c := range []string{"dapr_operator", "dapr_sentry", "dapr_sidecar_injector"} {
+				v, found, err := unstructured.NestedFieldNoCopy(values, c, "replicaCount")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(found).To(BeTrue(), c)
+				g.Expect(v).To(Equal(tt.replicas), c)
+			}
+
+			ha, _, err := unstructured.NestedBool(values, "dapr_placement", "ha")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(ha).To(Equal(tt.ha))
+
+			storage, _, err := unstructured.NestedString(values, "dapr_scheduler", "cluster", "storageSize")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(storage).To(Equal(tt.storage))
+
+			// the chart always runs 3 replicas of the scheduler, which the profiles do not
+			// pretend to change
+			g.Expect(values).To(HaveKeyWithValue("dapr_scheduler", Not(HaveKey("replicaCount"))))
+
+			for _, c := range []string{"dapr_operator", "dapr_placement", "dapr_scheduler", "dapr_sentry", "dapr_sidecar_injector"} {
+				_, found, err := unstructured.NestedMap(values, c, "resources", "requests")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(found).To(BeTrue(), c)
+			}
+		})
+	}
+}
+
+func TestValuesWithoutProfile(t *testing.T) {
+	for _, p := range []daprApi.SizingProfile{"", daprApi.SizingProfileCustom} {
+		t.Run(string(p), func(t *testing.T) {
+			g := NewWithT(t)
+
+			values, err := sizing.Values(p)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(values).ToNot(BeNil())
+			g.Expect(values).To(BeEmpty())
+		})
+	}
+}
+
+func TestValuesUnknownProfile(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := sizing.Values("huge")
+	g.Expect(err).To(MatchError(sizing.ErrUnknownProfile))
+
+	_, err = sizing.Definition("huge")
+	g.Expect(err).To(MatchError(sizing.ErrUnknownProfile))
+}
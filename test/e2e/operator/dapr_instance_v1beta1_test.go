@@ -0,0 +1,169 @@
+package operator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lburgazzoli/gomega-matchers/pkg/matchers/jq"
+
+	"github.com/dapr/kubernetes-operator/pkg/conditions"
+	"github.com/dapr/kubernetes-operator/test/support/dapr"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	. "github.com/dapr/kubernetes-operator/test/support"
+	. "github.com/onsi/gomega"
+
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	daprAc "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+)
+
+func TestDaprInstanceDeployWithPatches(t *testing.T) {
+	test := With(t)
+
+	instance := dapr.DeployInstanceV1Beta1(
+		test,
+		daprAc.DaprInstanceSpec().
+			WithPatches(
+				daprAc.Patch().
+					WithTarget(daprAc.PatchTarget().
+						WithKind("Deployment").
+						WithName("dapr-operator")).
+					WithPatch(`{"spec":{"template":{"metadata":{"annotations":{"e2e.dapr.io/patched":"`+test.ID()+`"}}}}}`),
+				daprAc.Patch().
+					WithTarget(daprAc.PatchTarget().
+						WithKind("Deployment").
+						WithLabelSelector("app=dapr-sentry")).
+					WithType(daprApi.PatchTypeJSON6902).
+					WithPatch(`[{"op":"add","path":"/metadata/labels/e2e.dapr.io~1patched","value":"`+test.ID()+`"}]`),
+				daprAc.Patch().
+					WithTarget(daprAc.PatchTarget().
+						WithKind("StatefulSet").
+						WithName("dapr-missing")).
+					WithPatch(`{"metadata":{"labels":{"e2e.dapr.io/patched":"true"}}}`),
+			),
+	)
+
+	test.Eventually(Deployment(test, "dapr-operator", instance.Namespace), TestTimeoutLong).Should(And(
+		WithTransform(ConditionStatus(appsv1.DeploymentAvailable), Equal(corev1.ConditionTrue)),
+		WithTransform(json.Marshal,
+			jq.Match(`.spec.template.metadata.annotations."e2e.dapr.io/patched" == "%s"`, test.ID())),
+	))
+	test.Eventually(Deployment(test, "dapr-sentry", instance.Namespace), TestTimeoutLong).Should(And(
+		WithTransform(ConditionStatus(appsv1.DeploymentAvailable), Equal(corev1.ConditionTrue)),
+		WithTransform(json.Marshal,
+			jq.Match(`.metadata.labels."e2e.dapr.io/patched" == "%s"`, test.ID())),
+	))
+
+	// the last patch matches no resource, which is reported but does not fail the
+	// reconciliation
+	test.Eventually(dapr.InstanceV1Beta1(test, instance), TestTimeoutLong).Should(
+		WithTransform(ConditionStatus(conditions.TypeReconciled), Equal(corev1.ConditionTrue)))
+}
+
+func TestDaprInstanceRejectInvalidPatch(t *testing.T) {
+	test := With(t)
+
+	_, err := dapr.ApplyInstanceV1Beta1(
+		test,
+		daprAc.DaprInstanceSpec().
+			WithPatches(
+				daprAc.Patch().
+					WithTarget(daprAc.PatchTarget().
+						WithKind("Deployment").
+						WithName("dapr-operator")).
+					WithType(daprApi.PatchTypeJSON6902).
+					WithPatch(`[{"op":"remove","path":"/spec/missing"}]`),
+			),
+	)
+
+	test.Expect(err).To(HaveOccurred())
+	test.Expect(k8serrors.IsInvalid(err)).To(BeTrue(), "expected the webhook to reject the patch, got %v", err)
+	test.Expect(err.Error()).To(ContainSubstring("spec.patches"))
+}
+
+func TestDaprInstanceDeployWithAutoscaling(t *testing.T) {
+	test := With(t)
+
+	instance := dapr.DeployInstanceV1Beta1(
+		test,
+		daprAc.DaprInstanceSpec().
+			WithAutoscaling(daprAc.AutoscalingSpec().
+				WithOperator(daprAc.ComponentAutoscalingSpec().
+					WithMinReplicas(2).
+					WithMaxReplicas(3).
+					WithTargetCPUUtilizationPercentage(80))),
+	)
+
+	test.Eventually(HorizontalPodAutoscaler(test, "dapr-operator", instance.Namespace), TestTimeoutLong).Should(
+		WithTransform(json.Marshal, And(
+			jq.Match(`.spec.scaleTargetRef.kind == "Deployment"`),
+			jq.Match(`.spec.scaleTargetRef.name == "dapr-operator"`),
+			jq.Match(`.spec.minReplicas == 2`),
+			jq.Match(`.spec.maxReplicas == 3`),
+			jq.Match(`.spec.metrics[0].resource.name == "cpu"`),
+			jq.Match(`.spec.metrics[0].resource.target.averageUtilization == 80`),
+		)),
+	)
+
+	// the HorizontalPodAutoscaler enforces its minimum even without metrics, which the
+	// controller must not revert as it no longer applies the replicas
+	test.Eventually(Deployment(test, "dapr-operator", instance.Namespace), TestTimeoutLong).Should(And(
+		WithTransform(ConditionStatus(appsv1.DeploymentAvailable), Equal(corev1.ConditionTrue)),
+		WithTransform(json.Marshal, jq.Match(`.spec.replicas == 2`)),
+	))
+	test.Consistently(Deployment(test, "dapr-operator", instance.Namespace), TestTimeoutShort).Should(
+		WithTransform(json.Marshal, jq.Match(`.spec.replicas == 2`)))
+
+	test.Eventually(dapr.InstanceV1Beta1(test, instance), TestTimeoutLong).Should(
+		WithTransform(ConditionStatus(conditions.TypeReconciled), Equal(corev1.ConditionTrue)))
+}
+
+func TestDaprInstanceDeployWithAvailability(t *testing.T) {
+	test := With(t)
+
+	name := "dapr-e2e-" + test.ID()
+
+	spec := func(value int32) *daprAc.DaprInstanceSpecApplyConfiguration {
+		return daprAc.DaprInstanceSpec().
+			WithAutoscaling(daprAc.AutoscalingSpec().
+				WithOperator(daprAc.ComponentAutoscalingSpec().
+					WithMinReplicas(2).
+					WithMaxReplicas(3))).
+			WithAvailability(daprAc.AvailabilitySpec().
+				WithPodDisruptionBudgets(daprApi.PodDisruptionBudgetPolicyManaged).
+				WithPriorityClass(daprAc.PriorityClassSpec().
+					WithName(name).
+					WithValue(value)))
+	}
+
+	instance := dapr.DeployInstanceV1Beta1(test, spec(1000))
+
+	// the autoscaled operator may run more than one replica, while the sentry runs a single
+	// replica outside of the high availability mode
+	test.Eventually(PodDisruptionBudget(test, "dapr-operator-disruption-budget", instance.Namespace), TestTimeoutLong).Should(
+		WithTransform(json.Marshal, And(
+			jq.Match(`.spec.maxUnavailable == 1`),
+			jq.Match(`.status.disruptionsAllowed >= 1`),
+		)),
+	)
+	test.Consistently(PodDisruptionBudget(test, "dapr-sentry-disruption-budget", instance.Namespace), TestTimeoutShort).Should(
+		BeNil())
+
+	test.Eventually(PriorityClass(test, name), TestTimeoutLong).Should(
+		WithTransform(json.Marshal, jq.Match(`.value == 1000`)))
+	test.Eventually(Deployment(test, "dapr-operator", instance.Namespace), TestTimeoutLong).Should(And(
+		WithTransform(ConditionStatus(appsv1.DeploymentAvailable), Equal(corev1.ConditionTrue)),
+		WithTransform(json.Marshal, jq.Match(`.spec.template.spec.priorityClassName == "%s"`, name)),
+	))
+
+	// the value of a PriorityClass is immutable, the PriorityClass is re-created
+	dapr.DeployInstanceV1Beta1(test, spec(2000))
+
+	test.Eventually(PriorityClass(test, name), TestTimeoutLong).Should(
+		WithTransform(json.Marshal, jq.Match(`.value == 2000`)))
+	test.Eventually(dapr.InstanceV1Beta1(test, instance), TestTimeoutLong).Should(
+		WithTransform(ConditionStatus(conditions.TypeReconciled), Equal(corev1.ConditionTrue)))
+}
@@ -0,0 +1,91 @@
+package dapr
+
+import (
+	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/internal/controller/operator/instance"
+	daprAc "github.com/dapr/kubernetes-operator/pkg/client/applyconfiguration/operator/v1beta1"
+	"github.com/dapr/kubernetes-operator/pkg/controller"
+	"github.com/dapr/kubernetes-operator/test/support"
+	"github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// InstanceV1Beta1 is the v1beta1 counterpart of Instance, to assert on the fields that are
+// only available in v1beta1.
+func InstanceV1Beta1(t support.Test, dapr *daprApi.DaprInstance) func(g gomega.Gomega) (*daprApi.DaprInstance, error) {
+	return func(g gomega.Gomega) (*daprApi.DaprInstance, error) {
+		answer, err := t.Client().Dapr().OperatorV1beta1().DaprInstances(dapr.Namespace).Get(
+			t.Ctx(),
+			dapr.Name,
+			metav1.GetOptions{},
+		)
+
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return answer, err
+	}
+}
+
+// ApplyInstanceV1Beta1 applies a DaprInstance through the v1beta1 API and returns the
+// error, if any, so the rejections of the admission webhook can be asserted.
+func ApplyInstanceV1Beta1(
+	t support.Test,
+	spec *daprAc.DaprInstanceSpecApplyConfiguration,
+	opts ...InstanceOption,
+) (*daprApi.DaprInstance, error) {
+	t.T().Helper()
+
+	io := InstanceOptions{}
+	io.Name = instance.DaprInstanceResourceName
+	io.Namespace = controller.NamespaceDefault
+
+	for _, o := range opts {
+		o(&io)
+	}
+
+	t.T().Logf("Setting up DaprInstance %s in namespace %s", io.Name, io.Namespace)
+
+	cp := t.Client().Dapr().OperatorV1beta1().DaprInstances(io.Namespace)
+
+	res, err := cp.Apply(
+		t.Ctx(),
+		daprAc.DaprInstance(io.Name, io.Namespace).
+			WithSpec(spec),
+		metav1.ApplyOptions{
+			FieldManager: "dapr-e2e-" + t.ID(),
+			Force:        true,
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.T().Logf("DaprInstance %s in namespace %s created", io.Name, io.Namespace)
+
+	t.Cleanup(func() []runtime.Object {
+		return []runtime.Object{res}
+	})
+
+	return res, nil
+}
+
+// DeployInstanceV1Beta1 is the v1beta1 counterpart of DeployInstance, to set the fields that
+// are only available in v1beta1.
+func DeployInstanceV1Beta1(
+	t support.Test,
+	spec *daprAc.DaprInstanceSpecApplyConfiguration,
+	opts ...InstanceOption,
+) *daprApi.DaprInstance {
+	t.T().Helper()
+
+	res, err := ApplyInstanceV1Beta1(t, spec, opts...)
+
+	t.Expect(err).
+		ToNot(gomega.HaveOccurred())
+
+	return res
+}
@@ -3,8 +3,11 @@ package support
 import (
 	"github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -95,6 +98,54 @@ func Service(t Test, name string, namespace string) func(g gomega.Gomega) (*core
 	}
 }
 
+func HorizontalPodAutoscaler(t Test, name string, namespace string) func(g gomega.Gomega) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return func(g gomega.Gomega) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+		answer, err := t.Client().AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(
+			t.Ctx(),
+			name,
+			metav1.GetOptions{},
+		)
+
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return answer, err
+	}
+}
+
+func PodDisruptionBudget(t Test, name string, namespace string) func(g gomega.Gomega) (*policyv1.PodDisruptionBudget, error) {
+	return func(g gomega.Gomega) (*policyv1.PodDisruptionBudget, error) {
+		answer, err := t.Client().PolicyV1().PodDisruptionBudgets(namespace).Get(
+			t.Ctx(),
+			name,
+			metav1.GetOptions{},
+		)
+
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return answer, err
+	}
+}
+
+func PriorityClass(t Test, name string) func(g gomega.Gomega) (*schedulingv1.PriorityClass, error) {
+	return func(g gomega.Gomega) (*schedulingv1.PriorityClass, error) {
+		answer, err := t.Client().SchedulingV1().PriorityClasses().Get(
+			t.Ctx(),
+			name,
+			metav1.GetOptions{},
+		)
+
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return answer, err
+	}
+}
+
 func Ingress(t Test, name string, namespace string) func(g gomega.Gomega) (*netv1.Ingress, error) {
 	return func(g gomega.Gomega) (*netv1.Ingress, error) {
 		answer, err := t.Client().NetworkingV1().Ingresses(namespace).Get(
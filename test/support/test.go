@@ -12,6 +12,7 @@ import (
 	helmsupport "github.com/dapr/kubernetes-operator/test/support/helm"
 
 	daprApi "github.com/dapr/kubernetes-operator/api/operator/v1alpha1"
+	daprApiV1beta1 "github.com/dapr/kubernetes-operator/api/operator/v1beta1"
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/go-logr/logr/testr"
@@ -67,6 +68,10 @@ func init() {
 		panic(err)
 	}
 
+	if err := daprApiV1beta1.AddToScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+
 	if err := olmV1.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
 	}